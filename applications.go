@@ -26,6 +26,10 @@ type ApplicationsService struct {
 	client *Client
 }
 
+// Application represents a GitLab OAuth application. The Secret is only
+// returned when the application is created, so store it right away.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/applications.html
 type Application struct {
 	ID              int    `json:"id"`
 	ApplicationID   string `json:"application_id"`
@@ -64,6 +68,10 @@ func (s *ApplicationsService) CreateApplication(opt *CreateApplicationOptions, o
 	return a, resp, err
 }
 
+// ListApplicationsOptions represents the available ListApplications() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/applications.html#list-all-applications
 type ListApplicationsOptions ListOptions
 
 // ListApplications get a list of administrables applications by the authenticated user
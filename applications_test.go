@@ -39,6 +39,50 @@ func TestCreateApplication(t *testing.T) {
 	}
 }
 
+func TestCreateConfidentialApplication(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/applications",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"name":"tooling","redirect_uri":"https://tooling.example.com/callback","scopes":"api read_user","confidential":true}`)
+			fmt.Fprint(w, `
+{
+	"id":2,
+	"application_id":"5832fc6e14300a0d962240a8144466eef4ee93ef0d218477e55f11cf12fc3737",
+	"application_name":"tooling",
+	"secret":"ee1dd64b6adc89cf7e2c23099301ccc2c61b441064e9324d963c46902a85ec34",
+	"callback_url":"https://tooling.example.com/callback",
+	"confidential":true
+}`)
+		},
+	)
+
+	opt := &CreateApplicationOptions{
+		Name:         String("tooling"),
+		RedirectURI:  String("https://tooling.example.com/callback"),
+		Scopes:       String("api read_user"),
+		Confidential: Bool(true),
+	}
+	app, _, err := client.Applications.CreateApplication(opt)
+	if err != nil {
+		t.Errorf("Applications.CreateApplication returned error: %v", err)
+	}
+
+	want := &Application{
+		ID:              2,
+		ApplicationID:   "5832fc6e14300a0d962240a8144466eef4ee93ef0d218477e55f11cf12fc3737",
+		ApplicationName: "tooling",
+		Secret:          "ee1dd64b6adc89cf7e2c23099301ccc2c61b441064e9324d963c46902a85ec34",
+		CallbackURL:     "https://tooling.example.com/callback",
+		Confidential:    true,
+	}
+	if !reflect.DeepEqual(want, app) {
+		t.Errorf("Applications.CreateApplication returned %+v, want %+v", app, want)
+	}
+}
+
 func TestListApplications(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
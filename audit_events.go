@@ -0,0 +1,183 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// AuditEvent represents an audit event for a group, a project or the instance.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type AuditEvent struct {
+	ID         int                    `json:"id"`
+	AuthorID   int                    `json:"author_id"`
+	EntityID   int                    `json:"entity_id"`
+	EntityType string                 `json:"entity_type"`
+	Details    map[string]interface{} `json:"details"`
+	CreatedAt  *time.Time             `json:"created_at"`
+}
+
+// AuditEventsService handles communication with the project/group/instance
+// audit event related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type AuditEventsService struct {
+	client *Client
+}
+
+// ListAuditEventsOptions represents the available ListInstanceAuditEvents(),
+// ListGroupAuditEvents() or ListProjectAuditEvents() options. The EntityType
+// and EntityID filters are only supported for instance audit events.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/audit_events.html
+type ListAuditEventsOptions struct {
+	ListOptions
+	CreatedAfter  *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+	EntityType    *string    `url:"entity_type,omitempty" json:"entity_type,omitempty"`
+	EntityID      *int       `url:"entity_id,omitempty" json:"entity_id,omitempty"`
+}
+
+// ListInstanceAuditEvents gets a list of audit events for the instance.
+// Authentication as an administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-instance-audit-events
+func (s *AuditEventsService) ListInstanceAuditEvents(opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	req, err := s.client.NewRequest("GET", "audit_events", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetInstanceAuditEvent gets a specific instance audit event.
+// Authentication as an administrator is required.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-single-instance-audit-event
+func (s *AuditEventsService) GetInstanceAuditEvent(event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	u := fmt.Sprintf("audit_events/%d", event)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
+
+// ListGroupAuditEvents gets a list of audit events for the specified group
+// viewable by the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-group-audit-events
+func (s *AuditEventsService) ListGroupAuditEvents(gid interface{}, opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/audit_events", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetGroupAuditEvent gets a specific group audit event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-a-specific-group-audit-event
+func (s *AuditEventsService) GetGroupAuditEvent(gid interface{}, event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/audit_events/%d", pathEscape(group), event)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
+
+// ListProjectAuditEvents gets a list of audit events for the specified
+// project viewable by the authenticated user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-all-project-audit-events
+func (s *AuditEventsService) ListProjectAuditEvents(pid interface{}, opt *ListAuditEventsOptions, options ...RequestOptionFunc) ([]*AuditEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/audit_events", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aes []*AuditEvent
+	resp, err := s.client.Do(req, &aes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return aes, resp, err
+}
+
+// GetProjectAuditEvent gets a specific project audit event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/audit_events.html#retrieve-a-specific-project-audit-event
+func (s *AuditEventsService) GetProjectAuditEvent(pid interface{}, event int, options ...RequestOptionFunc) (*AuditEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/audit_events/%d", pathEscape(project), event)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ae := new(AuditEvent)
+	resp, err := s.client.Do(req, ae)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ae, resp, err
+}
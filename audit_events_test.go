@@ -0,0 +1,103 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListInstanceAuditEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/audit_events?created_after=2019-01-01T00%3A00%3A00Z&entity_id=6&entity_type=Project")
+		fmt.Fprint(w, `[
+			{
+				"id": 1,
+				"author_id": 1,
+				"entity_id": 6,
+				"entity_type": "Project",
+				"details": {
+					"custom_message": "Project archived",
+					"author_name": "Administrator",
+					"target_id": "flightjs/flight",
+					"ip_address": "127.0.0.1"
+				},
+				"created_at": "2019-08-30T07:00:41.885Z"
+			}
+		]`)
+	})
+
+	opt := &ListAuditEventsOptions{
+		CreatedAfter: Time(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)),
+		EntityType:   String("Project"),
+		EntityID:     Int(6),
+	}
+	events, _, err := client.AuditEvents.ListInstanceAuditEvents(opt)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2019, time.August, 30, 7, 0, 41, 885000000, time.UTC)
+	want := []*AuditEvent{{
+		ID:         1,
+		AuthorID:   1,
+		EntityID:   6,
+		EntityType: "Project",
+		Details: map[string]interface{}{
+			"custom_message": "Project archived",
+			"author_name":    "Administrator",
+			"target_id":      "flightjs/flight",
+			"ip_address":     "127.0.0.1",
+		},
+		CreatedAt: &createdAt,
+	}}
+	assert.Equal(t, want, events)
+}
+
+func TestGetGroupAuditEvent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/60/audit_events/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "author_id": 1, "entity_id": 60, "entity_type": "Group", "details": {"add": "group", "author_name": "Administrator"}}`)
+	})
+
+	event, _, err := client.AuditEvents.GetGroupAuditEvent(60, 2)
+	require.NoError(t, err)
+
+	want := &AuditEvent{
+		ID:         2,
+		AuthorID:   1,
+		EntityID:   60,
+		EntityType: "Group",
+		Details:    map[string]interface{}{"add": "group", "author_name": "Administrator"},
+	}
+	assert.Equal(t, want, event)
+}
+
+func TestListProjectAuditEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/7/audit_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/7/audit_events?created_before=2020-01-01T00%3A00%3A00Z&page=2")
+		fmt.Fprint(w, `[{"id": 5, "author_id": 1, "entity_id": 7, "entity_type": "Project"}]`)
+	})
+
+	opt := &ListAuditEventsOptions{
+		ListOptions:   ListOptions{Page: 2},
+		CreatedBefore: Time(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	events, _, err := client.AuditEvents.ListProjectAuditEvents(7, opt)
+	require.NoError(t, err)
+
+	want := []*AuditEvent{{ID: 5, AuthorID: 1, EntityID: 7, EntityType: "Project"}}
+	assert.Equal(t, want, events)
+}
@@ -224,7 +224,7 @@ func (s *AwardEmojiService) createAwardEmoji(pid interface{}, resource string, r
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/award_emoji.html#award-a-new-emoji-on-a-note
 func (s *AwardEmojiService) DeleteIssueAwardEmoji(pid interface{}, issueIID, awardID int, options ...RequestOptionFunc) (*Response, error) {
-	return s.deleteAwardEmoji(pid, awardMergeRequest, issueIID, awardID, options...)
+	return s.deleteAwardEmoji(pid, awardIssue, issueIID, awardID, options...)
 }
 
 // DeleteMergeRequestAwardEmoji delete award emoji on a merge request.
@@ -240,7 +240,7 @@ func (s *AwardEmojiService) DeleteMergeRequestAwardEmoji(pid interface{}, mergeR
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/award_emoji.html#award-a-new-emoji-on-a-note
 func (s *AwardEmojiService) DeleteSnippetAwardEmoji(pid interface{}, snippetID, awardID int, options ...RequestOptionFunc) (*Response, error) {
-	return s.deleteAwardEmoji(pid, awardMergeRequest, snippetID, awardID, options...)
+	return s.deleteAwardEmoji(pid, awardSnippets, snippetID, awardID, options...)
 }
 
 // DeleteAwardEmoji Delete an award emoji on the specified resource.
@@ -262,6 +262,100 @@ func (s *AwardEmojiService) deleteAwardEmoji(pid interface{}, resource string, r
 	return s.client.Do(req, nil)
 }
 
+// ListEpicAwardEmoji gets a list of all award emoji on the epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#list-an-awardables-award-emojis
+func (s *AwardEmojiService) ListEpicAwardEmoji(gid interface{}, epicIID int, opt *ListAwardEmojiOptions, options ...RequestOptionFunc) ([]*AwardEmoji, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/award_emoji", pathEscape(group), epicIID)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var as []*AwardEmoji
+	resp, err := s.client.Do(req, &as)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as, resp, err
+}
+
+// GetEpicAwardEmoji get an award emoji from epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#get-single-award-emoji
+func (s *AwardEmojiService) GetEpicAwardEmoji(gid interface{}, epicIID, awardID int, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/award_emoji/%d", pathEscape(group), epicIID, awardID)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AwardEmoji)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// CreateEpicAwardEmoji awards an emoji on the epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#award-a-new-emoji
+func (s *AwardEmojiService) CreateEpicAwardEmoji(gid interface{}, epicIID int, opt *CreateAwardEmojiOptions, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/award_emoji", pathEscape(group), epicIID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AwardEmoji)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// DeleteEpicAwardEmoji delete award emoji on an epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#delete-an-award-emoji
+func (s *AwardEmojiService) DeleteEpicAwardEmoji(gid interface{}, epicIID, awardID int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/award_emoji/%d", pathEscape(group), epicIID, awardID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ListIssuesAwardEmojiOnNote gets a list of all award emoji on a note from the
 // issue.
 //
@@ -465,3 +559,98 @@ func (s *AwardEmojiService) deleteAwardEmojiOnNote(pid interface{}, resource str
 
 	return s.client.Do(req, nil)
 }
+
+// ListEpicAwardEmojiOnNote gets a list of all award emoji on a note from the
+// epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#award-emoji-on-comments
+func (s *AwardEmojiService) ListEpicAwardEmojiOnNote(gid interface{}, epicIID, noteID int, opt *ListAwardEmojiOptions, options ...RequestOptionFunc) ([]*AwardEmoji, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d/award_emoji", pathEscape(group), epicIID, noteID)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var as []*AwardEmoji
+	resp, err := s.client.Do(req, &as)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as, resp, err
+}
+
+// GetEpicAwardEmojiOnNote gets an award emoji on a note from an epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#award-emoji-on-comments
+func (s *AwardEmojiService) GetEpicAwardEmojiOnNote(gid interface{}, epicIID, noteID, awardID int, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d/award_emoji/%d", pathEscape(group), epicIID, noteID, awardID)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AwardEmoji)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// CreateEpicAwardEmojiOnNote awards an emoji on a note from an epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#award-a-new-emoji-on-a-comment
+func (s *AwardEmojiService) CreateEpicAwardEmojiOnNote(gid interface{}, epicIID, noteID int, opt *CreateAwardEmojiOptions, options ...RequestOptionFunc) (*AwardEmoji, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d/award_emoji", pathEscape(group), epicIID, noteID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(AwardEmoji)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// DeleteEpicAwardEmojiOnNote deletes an award emoji on a note from an epic.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/award_emoji.html#delete-an-award-emoji-from-a-comment
+func (s *AwardEmojiService) DeleteEpicAwardEmojiOnNote(gid interface{}, epicIID, noteID, awardID int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/epics/%d/notes/%d/award_emoji/%d", pathEscape(group), epicIID, noteID, awardID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,192 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListEpicAwardEmoji(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/5/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":4,"name":"thumbsup","user":{"id":1,"username":"root"},"awardable_id":80,"awardable_type":"Epic"}]`)
+	})
+
+	awards, _, err := client.AwardEmoji.ListEpicAwardEmoji(1, 5, nil)
+	require.NoError(t, err)
+
+	want := &AwardEmoji{ID: 4, Name: "thumbsup", AwardableID: 80, AwardableType: "Epic"}
+	want.User.ID = 1
+	want.User.Username = "root"
+	assert.Equal(t, []*AwardEmoji{want}, awards)
+}
+
+func TestGetEpicAwardEmoji(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/5/award_emoji/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":4,"name":"thumbsdown","awardable_id":80,"awardable_type":"Epic"}`)
+	})
+
+	award, _, err := client.AwardEmoji.GetEpicAwardEmoji(1, 5, 4)
+	require.NoError(t, err)
+	assert.Equal(t, &AwardEmoji{ID: 4, Name: "thumbsdown", AwardableID: 80, AwardableType: "Epic"}, award)
+}
+
+func TestCreateEpicAwardEmoji(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/5/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"rocket"}`)
+		fmt.Fprint(w, `{"id":5,"name":"rocket","awardable_id":80,"awardable_type":"Epic"}`)
+	})
+
+	award, _, err := client.AwardEmoji.CreateEpicAwardEmoji(1, 5, &CreateAwardEmojiOptions{Name: "rocket"})
+	require.NoError(t, err)
+	assert.Equal(t, &AwardEmoji{ID: 5, Name: "rocket", AwardableID: 80, AwardableType: "Epic"}, award)
+}
+
+func TestDeleteEpicAwardEmoji(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/5/award_emoji/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AwardEmoji.DeleteEpicAwardEmoji(1, 5, 4)
+	require.NoError(t, err)
+}
+
+func TestDeleteIssueAwardEmoji(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/80/award_emoji/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AwardEmoji.DeleteIssueAwardEmoji(1, 80, 4)
+	require.NoError(t, err)
+}
+
+func TestDeleteSnippetAwardEmoji(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/3/award_emoji/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AwardEmoji.DeleteSnippetAwardEmoji(1, 3, 4)
+	require.NoError(t, err)
+}
+
+func TestListMergeRequestAwardEmojiOnNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/7/notes/42/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/merge_requests/7/notes/42/award_emoji?page=1&per_page=100")
+		fmt.Fprint(w, `[
+			{"id":2,"name":"thumbsup","awardable_id":42,"awardable_type":"Note"},
+			{"id":3,"name":"thumbsdown","awardable_id":42,"awardable_type":"Note"}
+		]`)
+	})
+
+	opt := &ListAwardEmojiOptions{Page: 1, PerPage: 100}
+	awards, _, err := client.AwardEmoji.ListMergeRequestAwardEmojiOnNote(1, 7, 42, opt)
+	require.NoError(t, err)
+
+	want := []*AwardEmoji{
+		{ID: 2, Name: "thumbsup", AwardableID: 42, AwardableType: "Note"},
+		{ID: 3, Name: "thumbsdown", AwardableID: 42, AwardableType: "Note"},
+	}
+	assert.Equal(t, want, awards)
+}
+
+func TestCreateIssuesAwardEmojiOnNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/80/notes/42/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"thumbsup"}`)
+		fmt.Fprint(w, `{"id":2,"name":"thumbsup","awardable_id":42,"awardable_type":"Note"}`)
+	})
+
+	award, _, err := client.AwardEmoji.CreateIssuesAwardEmojiOnNote(1, 80, 42, &CreateAwardEmojiOptions{Name: "thumbsup"})
+	require.NoError(t, err)
+	assert.Equal(t, &AwardEmoji{ID: 2, Name: "thumbsup", AwardableID: 42, AwardableType: "Note"}, award)
+}
+
+func TestDeleteSnippetAwardEmojiOnNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/3/notes/42/award_emoji/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.AwardEmoji.DeleteSnippetAwardEmojiOnNote(1, 3, 42, 2)
+	require.NoError(t, err)
+}
+
+func TestEpicAwardEmojiOnNote(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/5/notes/42/award_emoji", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id":2,"name":"eyes","awardable_id":42,"awardable_type":"Note"}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"eyes"}`)
+			fmt.Fprint(w, `{"id":2,"name":"eyes","awardable_id":42,"awardable_type":"Note"}`)
+		default:
+			t.Errorf("Request method: %s, want GET or POST", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v4/groups/1/epics/5/notes/42/award_emoji/2", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"id":2,"name":"eyes","awardable_id":42,"awardable_type":"Note"}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Request method: %s, want GET or DELETE", r.Method)
+		}
+	})
+
+	want := &AwardEmoji{ID: 2, Name: "eyes", AwardableID: 42, AwardableType: "Note"}
+
+	award, _, err := client.AwardEmoji.CreateEpicAwardEmojiOnNote(1, 5, 42, &CreateAwardEmojiOptions{Name: "eyes"})
+	require.NoError(t, err)
+	assert.Equal(t, want, award)
+
+	awards, _, err := client.AwardEmoji.ListEpicAwardEmojiOnNote(1, 5, 42, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*AwardEmoji{want}, awards)
+
+	award, _, err = client.AwardEmoji.GetEpicAwardEmojiOnNote(1, 5, 42, 2)
+	require.NoError(t, err)
+	assert.Equal(t, want, award)
+
+	_, err = client.AwardEmoji.DeleteEpicAwardEmojiOnNote(1, 5, 42, 2)
+	require.NoError(t, err)
+}
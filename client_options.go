@@ -1,9 +1,12 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
 )
 
 // ClientOptionFunc can be used customize a new GitLab API client.
@@ -32,14 +35,38 @@ func WithCustomRetry(checkRetry retryablehttp.CheckRetry) ClientOptionFunc {
 	}
 }
 
-// WithHTTPClient can be used to configure a custom HTTP client.
+// WithHTTPClient can be used to configure a custom HTTP client. If the HTTP
+// client has no redirect policy, a copy of it is used with the default policy
+// of the client, which makes sure the private token isn't sent along when
+// GitLab redirects to another host.
 func WithHTTPClient(httpClient *http.Client) ClientOptionFunc {
 	return func(c *Client) error {
+		if httpClient.CheckRedirect == nil {
+			hc := *httpClient
+			hc.CheckRedirect = checkRedirect
+			httpClient = &hc
+		}
 		c.client.HTTPClient = httpClient
 		return nil
 	}
 }
 
+// WithOAuthTokenSource can be used to configure a token source, which is used
+// to get the OAuth token for every request instead of a static token. This
+// makes sure a long running client keeps working when short-lived tokens
+// expire. If a request is rejected with a 401 Unauthorized response, a new
+// token is requested from the token source and the request is retried once.
+//
+// Note that a token source created by oauth2.Config.TokenSource caches the
+// token until it expires, so in that case a request is only retried when the
+// token expired while the request was in flight.
+func WithOAuthTokenSource(ts oauth2.TokenSource) ClientOptionFunc {
+	return func(c *Client) error {
+		c.tokenSource = ts
+		return nil
+	}
+}
+
 // WithoutRetries disables the default retry logic.
 func WithoutRetries() ClientOptionFunc {
 	return func(c *Client) error {
@@ -47,3 +74,47 @@ func WithoutRetries() ClientOptionFunc {
 		return nil
 	}
 }
+
+// WithRequestLogger can be used to observe every attempt of an API request.
+// The logger is called with the method, URL, status code, duration and
+// attempt number (starting at 1) of each attempt, also when an attempt failed
+// or returned an error response. The headers and body of a request are never
+// passed to the logger. By default no requests are logged.
+func WithRequestLogger(logger RequestLogger) ClientOptionFunc {
+	return func(c *Client) error {
+		c.requestLogger = logger
+		return nil
+	}
+}
+
+// WithRetry configures a retry policy for failed requests. Requests that are
+// rejected because of the rate limit (429) or that fail because of a server
+// error (>= 500) are retried at most maxRetries times.
+//
+// By default only safe methods (GET and HEAD) are retried. Other requests can
+// be marked as retryable using the WithRetryable request option. The bodies of
+// retried requests are rewound before each attempt.
+//
+// The backoff function returns the time to wait before the given retry
+// attempt (starting at 1). If backoff is nil, an exponential backoff is used.
+// In both cases the Retry-After header is honored when present. Retrying
+// stops immediately when the context of the request is cancelled.
+func WithRetry(maxRetries int, backoff func(attempt int) time.Duration) ClientOptionFunc {
+	return func(c *Client) error {
+		if maxRetries < 0 {
+			return fmt.Errorf("invalid number of retries: %d", maxRetries)
+		}
+		c.client.RetryMax = maxRetries
+		c.client.CheckRetry = c.retryPolicyCheck
+		c.client.Backoff = func(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+			if wait, ok := retryAfter(resp); ok {
+				return wait
+			}
+			if backoff != nil {
+				return backoff(attemptNum + 1)
+			}
+			return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+		}
+		return nil
+	}
+}
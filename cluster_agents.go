@@ -0,0 +1,268 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ClusterAgentsService handles communication with the cluster agents related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/cluster_agents.html
+type ClusterAgentsService struct {
+	client *Client
+}
+
+// ClusterAgent represents a GitLab agent for Kubernetes.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/cluster_agents.html
+type ClusterAgent struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	ConfigProject   *Project   `json:"config_project"`
+	CreatedAt       *time.Time `json:"created_at"`
+	CreatedByUserID int        `json:"created_by_user_id"`
+}
+
+func (a ClusterAgent) String() string {
+	return Stringify(a)
+}
+
+// AgentToken represents a token of a GitLab agent for Kubernetes. The Token
+// itself is only returned once, when the token is created.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#list-tokens-for-an-agent
+type AgentToken struct {
+	ID              int        `json:"id"`
+	Name            string     `json:"name"`
+	Description     string     `json:"description"`
+	AgentID         int        `json:"agent_id"`
+	Status          string     `json:"status"`
+	CreatedAt       *time.Time `json:"created_at"`
+	CreatedByUserID int        `json:"created_by_user_id"`
+	LastUsedAt      *time.Time `json:"last_used_at"`
+	Token           string     `json:"token"`
+}
+
+func (t AgentToken) String() string {
+	return Stringify(t)
+}
+
+// ListAgentsOptions represents the available ListAgents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#list-the-agents-for-a-project
+type ListAgentsOptions ListOptions
+
+// ListAgents lists the agents registered for a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#list-the-agents-for-a-project
+func (s *ClusterAgentsService) ListAgents(pid interface{}, opt *ListAgentsOptions, options ...RequestOptionFunc) ([]*ClusterAgent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var as []*ClusterAgent
+	resp, err := s.client.Do(req, &as)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return as, resp, err
+}
+
+// GetAgent gets a single agent of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#get-details-about-an-agent
+func (s *ClusterAgentsService) GetAgent(pid interface{}, agent int, options ...RequestOptionFunc) (*ClusterAgent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents/%d", pathEscape(project), agent)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(ClusterAgent)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// RegisterAgentOptions represents the available RegisterAgent() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#register-an-agent-with-a-project
+type RegisterAgentOptions struct {
+	Name *string `url:"name,omitempty" json:"name,omitempty"`
+}
+
+// RegisterAgent registers a new agent with a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#register-an-agent-with-a-project
+func (s *ClusterAgentsService) RegisterAgent(pid interface{}, opt *RegisterAgentOptions, options ...RequestOptionFunc) (*ClusterAgent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	a := new(ClusterAgent)
+	resp, err := s.client.Do(req, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return a, resp, err
+}
+
+// DeleteAgent deletes an agent of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#delete-a-registered-agent
+func (s *ClusterAgentsService) DeleteAgent(pid interface{}, agent int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents/%d", pathEscape(project), agent)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListAgentTokensOptions represents the available ListAgentTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#list-tokens-for-an-agent
+type ListAgentTokensOptions ListOptions
+
+// ListAgentTokens lists the active tokens of an agent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#list-tokens-for-an-agent
+func (s *ClusterAgentsService) ListAgentTokens(pid interface{}, agent int, opt *ListAgentTokensOptions, options ...RequestOptionFunc) ([]*AgentToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents/%d/tokens", pathEscape(project), agent)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ts []*AgentToken
+	resp, err := s.client.Do(req, &ts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ts, resp, err
+}
+
+// GetAgentToken gets a single token of an agent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#get-a-single-agent-token
+func (s *ClusterAgentsService) GetAgentToken(pid interface{}, agent int, token int, options ...RequestOptionFunc) (*AgentToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents/%d/tokens/%d", pathEscape(project), agent, token)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(AgentToken)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// CreateAgentTokenOptions represents the available CreateAgentToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#create-an-agent-token
+type CreateAgentTokenOptions struct {
+	Name        *string `url:"name,omitempty" json:"name,omitempty"`
+	Description *string `url:"description,omitempty" json:"description,omitempty"`
+}
+
+// CreateAgentToken creates a new token for an agent. The returned AgentToken
+// is the only one that contains the token value.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#create-an-agent-token
+func (s *ClusterAgentsService) CreateAgentToken(pid interface{}, agent int, opt *CreateAgentTokenOptions, options ...RequestOptionFunc) (*AgentToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents/%d/tokens", pathEscape(project), agent)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(AgentToken)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, err
+}
+
+// RevokeAgentToken revokes a token of an agent.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/cluster_agents.html#revoke-an-agent-token
+func (s *ClusterAgentsService) RevokeAgentToken(pid interface{}, agent int, token int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/cluster_agents/%d/tokens/%d", pathEscape(project), agent, token)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,146 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAgents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/20/cluster_agents?page=1&per_page=10")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"name": "agent-1",
+			"config_project": {"id": 20, "path_with_namespace": "root/agent-config"},
+			"created_at": "2022-04-20T20:42:40.221Z",
+			"created_by_user_id": 42
+		}]`)
+	})
+
+	agents, _, err := client.ClusterAgents.ListAgents(20, &ListAgentsOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2022, time.April, 20, 20, 42, 40, 221000000, time.UTC)
+	want := []*ClusterAgent{{
+		ID:              1,
+		Name:            "agent-1",
+		ConfigProject:   &Project{ID: 20, PathWithNamespace: "root/agent-config"},
+		CreatedAt:       &createdAt,
+		CreatedByUserID: 42,
+	}}
+	assert.Equal(t, want, agents)
+}
+
+func TestGetAgent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"name":"agent-1"}`)
+	})
+
+	agent, _, err := client.ClusterAgents.GetAgent(20, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &ClusterAgent{ID: 1, Name: "agent-1"}, agent)
+}
+
+func TestRegisterAgent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"agent-2"}`)
+		fmt.Fprint(w, `{"id":2,"name":"agent-2"}`)
+	})
+
+	agent, _, err := client.ClusterAgents.RegisterAgent(20, &RegisterAgentOptions{Name: String("agent-2")})
+	require.NoError(t, err)
+	assert.Equal(t, &ClusterAgent{ID: 2, Name: "agent-2"}, agent)
+}
+
+func TestDeleteAgent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ClusterAgents.DeleteAgent(20, 1)
+	require.NoError(t, err)
+}
+
+func TestListAgentTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents/1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"name":"abcd","agent_id":1,"status":"active","created_by_user_id":1}]`)
+	})
+
+	tokens, _, err := client.ClusterAgents.ListAgentTokens(20, 1, nil)
+	require.NoError(t, err)
+
+	want := []*AgentToken{{ID: 1, Name: "abcd", AgentID: 1, Status: "active", CreatedByUserID: 1}}
+	assert.Equal(t, want, tokens)
+}
+
+func TestGetAgentToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents/1/tokens/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":2,"name":"abcd","description":"Some token","agent_id":1,"status":"active"}`)
+	})
+
+	token, _, err := client.ClusterAgents.GetAgentToken(20, 1, 2)
+	require.NoError(t, err)
+
+	want := &AgentToken{ID: 2, Name: "abcd", Description: "Some token", AgentID: 1, Status: "active"}
+	assert.Equal(t, want, token)
+}
+
+func TestCreateAgentToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents/1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"abcd","description":"Some token"}`)
+		fmt.Fprint(w, `{"id":3,"name":"abcd","description":"Some token","agent_id":1,"status":"active","token":"glagent-qwerty"}`)
+	})
+
+	opt := &CreateAgentTokenOptions{Name: String("abcd"), Description: String("Some token")}
+	token, _, err := client.ClusterAgents.CreateAgentToken(20, 1, opt)
+	require.NoError(t, err)
+
+	want := &AgentToken{ID: 3, Name: "abcd", Description: "Some token", AgentID: 1, Status: "active", Token: "glagent-qwerty"}
+	assert.Equal(t, want, token)
+}
+
+func TestRevokeAgentToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/20/cluster_agents/1/tokens/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ClusterAgents.RevokeAgentToken(20, 1, 2)
+	require.NoError(t, err)
+}
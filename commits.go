@@ -115,6 +115,7 @@ const (
 	FileDelete FileAction = "delete"
 	FileMove   FileAction = "move"
 	FileUpdate FileAction = "update"
+	FileChmod  FileAction = "chmod"
 )
 
 // CommitAction represents a single file action within a commit.
@@ -122,12 +123,60 @@ type CommitAction struct {
 	Action          FileAction `url:"action" json:"action"`
 	FilePath        string     `url:"file_path" json:"file_path"`
 	PreviousPath    string     `url:"previous_path,omitempty" json:"previous_path,omitempty"`
-	Content         string     `url:"content,omitempty" json:"content,omitempty"`
+	Content         *string    `url:"content,omitempty" json:"content,omitempty"`
 	Encoding        string     `url:"encoding,omitempty" json:"encoding,omitempty"`
 	LastCommitID    string     `url:"last_commit_id,omitempty" json:"last_commit_id,omitempty"`
 	ExecuteFilemode bool       `url:"execute_filemode,omitempty" json:"execute_filemode,omitempty"`
 }
 
+// CreateFileAction returns a CommitAction which creates a new file with the
+// given content.
+func CreateFileAction(filePath, content string) *CommitAction {
+	return &CommitAction{Action: FileCreate, FilePath: filePath, Content: String(content)}
+}
+
+// UpdateFileAction returns a CommitAction which replaces the content of an
+// existing file.
+func UpdateFileAction(filePath, content string) *CommitAction {
+	return &CommitAction{Action: FileUpdate, FilePath: filePath, Content: String(content)}
+}
+
+// DeleteFileAction returns a CommitAction which deletes an existing file.
+func DeleteFileAction(filePath string) *CommitAction {
+	return &CommitAction{Action: FileDelete, FilePath: filePath}
+}
+
+// MoveFileAction returns a CommitAction which moves an existing file from
+// previousPath to filePath.
+func MoveFileAction(previousPath, filePath string) *CommitAction {
+	return &CommitAction{Action: FileMove, FilePath: filePath, PreviousPath: previousPath}
+}
+
+// Validate checks if the action has all the fields required by its action
+// type, so invalid actions are rejected before making an API request. Create
+// and update actions require content, which may be empty.
+func (a *CommitAction) Validate() error {
+	if a.FilePath == "" {
+		return fmt.Errorf("%s action requires a file path", a.Action)
+	}
+
+	switch a.Action {
+	case FileCreate, FileUpdate:
+		if a.Content == nil {
+			return fmt.Errorf("%s action for %s requires content", a.Action, a.FilePath)
+		}
+	case FileMove:
+		if a.PreviousPath == "" {
+			return fmt.Errorf("move action for %s requires a previous path", a.FilePath)
+		}
+	case FileDelete, FileChmod:
+	default:
+		return fmt.Errorf("invalid action %q for %s", a.Action, a.FilePath)
+	}
+
+	return nil
+}
+
 // CommitRef represents the reference of branches/tags in a commit.
 //
 // GitLab API docs:
@@ -139,6 +188,8 @@ type CommitRef struct {
 
 // GetCommitRefsOptions represents the available GetCommitRefs() options.
 //
+// Type limits the references to "branch" or "tag", it defaults to "all".
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/commits.html#get-references-a-commit-is-pushed-to
 type GetCommitRefsOptions struct {
@@ -199,6 +250,23 @@ func (s *CommitsService) GetCommit(pid interface{}, sha string, options ...Reque
 	return c, resp, err
 }
 
+// refNotFound determines which error to return after a request for the given
+// ref resulted in a 404. GitLab returns a 404 both when the ref doesn't exist
+// and when the requested resource doesn't exist for an existing ref, so check
+// if the ref exists. The 404 is wrapped with notFound if the ref exists and
+// with ErrRefNotFound if it doesn't, see probeNotFound.
+func (s *CommitsService) refNotFound(pid interface{}, ref string, notFound, err error, options []RequestOptionFunc) error {
+	return probeNotFound(err, s.probeRef(pid, ref, options), notFound, ErrRefNotFound)
+}
+
+// probeRef returns a probe for probeNotFound that checks if ref exists.
+func (s *CommitsService) probeRef(pid interface{}, ref string, options []RequestOptionFunc) func() (*Response, error) {
+	return func() (*Response, error) {
+		_, resp, err := s.GetCommit(pid, ref, options...)
+		return resp, err
+	}
+}
+
 // CreateCommitOptions represents the available options for a new commit.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
@@ -215,7 +283,8 @@ type CreateCommitOptions struct {
 	Force         *bool           `url:"force,omitempty" json:"force,omitempty"`
 }
 
-// CreateCommit creates a commit with multiple files and actions.
+// CreateCommit creates a commit with multiple files and actions. All actions
+// are validated before the request is made, see CommitAction.Validate.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#create-a-commit-with-multiple-files-and-actions
 func (s *CommitsService) CreateCommit(pid interface{}, opt *CreateCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
@@ -223,6 +292,16 @@ func (s *CommitsService) CreateCommit(pid interface{}, opt *CreateCommitOptions,
 	if err != nil {
 		return nil, nil, err
 	}
+	if opt != nil {
+		for i, action := range opt.Actions {
+			if action == nil {
+				return nil, nil, fmt.Errorf("action %d: action must not be nil", i)
+			}
+			if err := action.Validate(); err != nil {
+				return nil, nil, fmt.Errorf("action %d: %v", i, err)
+			}
+		}
+	}
 	u := fmt.Sprintf("projects/%s/repository/commits", pathEscape(project))
 
 	req, err := s.client.NewRequest("POST", u, opt, options)
@@ -399,17 +478,19 @@ type GetCommitStatusesOptions struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#get-the-status-of-a-commit
 type CommitStatus struct {
-	ID          int        `json:"id"`
-	SHA         string     `json:"sha"`
-	Ref         string     `json:"ref"`
-	Status      string     `json:"status"`
-	Name        string     `json:"name"`
-	TargetURL   string     `json:"target_url"`
-	Description string     `json:"description"`
-	CreatedAt   *time.Time `json:"created_at"`
-	StartedAt   *time.Time `json:"started_at"`
-	FinishedAt  *time.Time `json:"finished_at"`
-	Author      Author     `json:"author"`
+	ID           int        `json:"id"`
+	SHA          string     `json:"sha"`
+	Ref          string     `json:"ref"`
+	Status       string     `json:"status"`
+	Name         string     `json:"name"`
+	TargetURL    string     `json:"target_url"`
+	Description  string     `json:"description"`
+	Coverage     float64    `json:"coverage"`
+	AllowFailure bool       `json:"allow_failure"`
+	CreatedAt    *time.Time `json:"created_at"`
+	StartedAt    *time.Time `json:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at"`
+	Author       Author     `json:"author"`
 }
 
 // GetCommitStatuses gets the statuses of a commit in a project.
@@ -446,6 +527,8 @@ type SetCommitStatusOptions struct {
 	Context     *string         `url:"context,omitempty" json:"context,omitempty"`
 	TargetURL   *string         `url:"target_url,omitempty" json:"target_url,omitempty"`
 	Description *string         `url:"description,omitempty" json:"description,omitempty"`
+	Coverage    *float64        `url:"coverage,omitempty" json:"coverage,omitempty"`
+	PipelineID  *int            `url:"pipeline_id,omitempty" json:"pipeline_id,omitempty"`
 }
 
 // SetCommitStatus sets the status of a commit in a project.
@@ -501,10 +584,15 @@ func (s *CommitsService) GetMergeRequestsByCommit(pid interface{}, sha string, o
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#cherry-pick-a-commit
 type CherryPickCommitOptions struct {
-	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+	Branch  *string `url:"branch,omitempty" json:"branch,omitempty"`
+	DryRun  *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
+	Message *string `url:"message,omitempty" json:"message,omitempty"`
 }
 
-// CherryPickCommit cherry picks a commit to a given branch.
+// CherryPickCommit cherry picks a commit to a given branch. If the commit
+// can't be applied (for example because of a conflict), an *ErrorResponse
+// containing the message returned by GitLab is returned. When DryRun is set,
+// no commit is created and the returned commit is empty.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/commits.html#cherry-pick-a-commit
 func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *CherryPickCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
@@ -533,9 +621,13 @@ func (s *CommitsService) CherryPickCommit(pid interface{}, sha string, opt *Cher
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 type RevertCommitOptions struct {
 	Branch *string `url:"branch,omitempty" json:"branch,omitempty"`
+	DryRun *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-// RevertCommit reverts a commit in a given branch.
+// RevertCommit reverts a commit in a given branch. If the commit can't be
+// reverted (for example because of a conflict), an *ErrorResponse containing
+// the message returned by GitLab is returned. When DryRun is set, no commit
+// is created and the returned commit is empty.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/commits.html#revert-a-commit
 func (s *CommitsService) RevertCommit(pid interface{}, sha string, opt *RevertCommitOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
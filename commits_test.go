@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testRevertCommitTargetBranch = "release"
@@ -99,6 +101,28 @@ func TestSetCommitStatus(t *testing.T) {
 	}
 }
 
+func TestSetCommitStatusWithCoverage(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/statuses/b0b3a907f41409829b307a28b82fdbd552ee5a27", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"state":"success","name":"ext/tests","coverage":87.5,"pipeline_id":42}`)
+		fmt.Fprint(w, `{"id":1,"status":"success","name":"ext/tests","coverage":87.5}`)
+	})
+
+	opt := &SetCommitStatusOptions{State: Success, Name: String("ext/tests"), Coverage: Float64(87.5), PipelineID: Int(42)}
+	status, _, err := client.Commits.SetCommitStatus("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", opt)
+	if err != nil {
+		t.Errorf("Commits.SetCommitStatus returned error: %v", err)
+	}
+
+	want := &CommitStatus{ID: 1, Status: "success", Name: "ext/tests", Coverage: 87.5}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("Commits.SetCommitStatus returned %+v, want %+v", status, want)
+	}
+}
+
 func TestRevertCommit_NoOptions(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -213,3 +237,161 @@ func TestGetGPGSignature(t *testing.T) {
 
 	assert.Equal(t, want, sig)
 }
+
+func TestCherryPickCommit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"release"}`)
+		mustWriteHTTPResponse(t, w, "testdata/get_commit.json")
+	})
+
+	commit, resp, err := client.Commits.CherryPickCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", &CherryPickCommitOptions{
+		Branch: String("release"),
+	})
+	if err != nil {
+		t.Fatalf("Commits.CherryPickCommit returned error: %v, response: %v", err, resp)
+	}
+
+	assert.Equal(t, "6104942438c14ec7bd21c6cd5bd995272b3faff6", commit.ID)
+}
+
+func TestCherryPickCommit_Conflict(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/b0b3a907f41409829b307a28b82fdbd552ee5a27/cherry_pick", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"release","dry_run":true}`)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": "Sorry, we cannot cherry-pick this commit automatically."}`)
+	})
+
+	_, resp, err := client.Commits.CherryPickCommit("1", "b0b3a907f41409829b307a28b82fdbd552ee5a27", &CherryPickCommitOptions{
+		Branch: String("release"),
+		DryRun: Bool(true),
+	})
+
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		t.Fatalf("Commits.CherryPickCommit returned error %v, want *ErrorResponse", err)
+	}
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, "{message: Sorry, we cannot cherry-pick this commit automatically.}", errResp.Message)
+}
+
+func TestCreateCommit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"branch":"master","commit_message":"Update files","actions":[`+
+			`{"action":"create","file_path":"docs/new.md","content":"new"},`+
+			`{"action":"update","file_path":"README.md","content":"updated"},`+
+			`{"action":"delete","file_path":"old.txt"},`+
+			`{"action":"move","file_path":"docs/guide.md","previous_path":"guide.md"}]}`)
+		fmt.Fprint(w, `{"id": "ed899a2f4b50b4370feeea94676502b42383c746", "title": "Update files"}`)
+	})
+
+	opt := &CreateCommitOptions{
+		Branch:        String("master"),
+		CommitMessage: String("Update files"),
+		Actions: []*CommitAction{
+			CreateFileAction("docs/new.md", "new"),
+			UpdateFileAction("README.md", "updated"),
+			DeleteFileAction("old.txt"),
+			MoveFileAction("guide.md", "docs/guide.md"),
+		},
+	}
+	commit, _, err := client.Commits.CreateCommit(1, opt)
+	if err != nil {
+		t.Fatalf("Commits.CreateCommit returned error: %v", err)
+	}
+
+	assert.Equal(t, "ed899a2f4b50b4370feeea94676502b42383c746", commit.ID)
+	assert.Equal(t, "Update files", commit.Title)
+}
+
+func TestCreateCommitInvalidAction(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Commits.CreateCommit should not send a request for invalid actions")
+	})
+
+	tests := []struct {
+		action *CommitAction
+		want   string
+	}{
+		{&CommitAction{Action: FileCreate, FilePath: "new.md"}, "action 1: create action for new.md requires content"},
+		{&CommitAction{Action: FileUpdate, FilePath: "README.md"}, "action 1: update action for README.md requires content"},
+		{&CommitAction{Action: FileMove, FilePath: "docs/guide.md"}, "action 1: move action for docs/guide.md requires a previous path"},
+		{&CommitAction{Action: FileDelete}, "action 1: delete action requires a file path"},
+		{&CommitAction{Action: "copy", FilePath: "a.txt"}, `action 1: invalid action "copy" for a.txt`},
+		{nil, "action 1: action must not be nil"},
+	}
+
+	for _, tt := range tests {
+		opt := &CreateCommitOptions{
+			Branch:        String("master"),
+			CommitMessage: String("Update files"),
+			Actions:       []*CommitAction{DeleteFileAction("old.txt"), tt.action},
+		}
+		_, _, err := client.Commits.CreateCommit(1, opt)
+		if assert.Error(t, err) {
+			assert.Equal(t, tt.want, err.Error())
+		}
+	}
+}
+
+func TestCommitActionValidateEmptyContent(t *testing.T) {
+	assert.NoError(t, CreateFileAction("empty.txt", "").Validate())
+	assert.NoError(t, UpdateFileAction("README.md", "").Validate())
+
+	// Empty content is still sent, so GitLab creates an empty file.
+	b, err := json.Marshal(CreateFileAction("empty.txt", ""))
+	require.NoError(t, err)
+	assert.Equal(t, `{"action":"create","file_path":"empty.txt","content":""}`, string(b))
+}
+
+func TestGetCommitRefs(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/5937ac0a7beb003549fc5fd26fc247adbce4a52e/refs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/commits/5937ac0a7beb003549fc5fd26fc247adbce4a52e/refs?type=tag")
+		fmt.Fprint(w, `[{"type":"tag","name":"v1.1.0"},{"type":"tag","name":"v1.2.0"}]`)
+	})
+
+	opt := &GetCommitRefsOptions{Type: String("tag")}
+	refs, _, err := client.Commits.GetCommitRefs(1, "5937ac0a7beb003549fc5fd26fc247adbce4a52e", opt)
+	if err != nil {
+		t.Fatalf("Commits.GetCommitRefs returned error: %v", err)
+	}
+
+	want := []*CommitRef{{Type: "tag", Name: "v1.1.0"}, {Type: "tag", Name: "v1.2.0"}}
+	assert.Equal(t, want, refs)
+}
+
+func TestGetMergeRequestsByCommit(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/5937ac0a7beb003549fc5fd26fc247adbce4a52e/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":45,"iid":1,"project_id":1,"title":"Fix the login form","state":"merged"}]`)
+	})
+
+	mrs, _, err := client.Commits.GetMergeRequestsByCommit(1, "5937ac0a7beb003549fc5fd26fc247adbce4a52e")
+	if err != nil {
+		t.Fatalf("Commits.GetMergeRequestsByCommit returned error: %v", err)
+	}
+
+	want := []*MergeRequest{{ID: 45, IID: 1, ProjectID: 1, Title: "Fix the login form", State: "merged"}}
+	assert.Equal(t, want, mrs)
+}
@@ -0,0 +1,158 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// DependencyProxyService handles communication with the dependency proxy
+// related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dependency_proxy.html
+type DependencyProxyService struct {
+	client *Client
+}
+
+// PurgeDependencyProxyCache schedules the removal of all cached manifests and
+// blobs of the dependency proxy of a group. GitLab performs the purge
+// asynchronously and responds with 202 Accepted.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dependency_proxy.html#purge-the-dependency-proxy-for-a-group
+func (s *DependencyProxyService) PurgeDependencyProxyCache(gid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/dependency_proxy/cache", pathEscape(group))
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DependencyProxySettings represents the dependency proxy settings of a
+// group, including its cleanup policy and cached manifests.
+type DependencyProxySettings struct {
+	Enabled        bool                           `json:"enabled"`
+	ImageTTLPolicy *DependencyProxyImageTTLPolicy `json:"image_ttl_policy"`
+	BlobCount      int                            `json:"blob_count"`
+	ImageCount     int                            `json:"image_count"`
+	TotalSize      string                         `json:"total_size"`
+	Manifests      []*DependencyProxyManifest     `json:"manifests"`
+}
+
+func (s DependencyProxySettings) String() string {
+	return Stringify(s)
+}
+
+// DependencyProxyImageTTLPolicy represents the cleanup policy of the
+// dependency proxy of a group.
+type DependencyProxyImageTTLPolicy struct {
+	Enabled   bool       `json:"enabled"`
+	TTL       int        `json:"ttl"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// DependencyProxyManifest represents a manifest cached by the dependency
+// proxy of a group.
+type DependencyProxyManifest struct {
+	ID        string     `json:"id"`
+	FileName  string     `json:"file_name"`
+	ImageName string     `json:"image_name"`
+	Digest    string     `json:"digest"`
+	Size      string     `json:"size"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+const dependencyProxySettingsQuery = `query($fullPath: ID!) {
+  group(fullPath: $fullPath) {
+    dependencyProxySetting { enabled }
+    dependencyProxyImageTtlPolicy { enabled ttl createdAt updatedAt }
+    dependencyProxyBlobCount
+    dependencyProxyImageCount
+    dependencyProxyTotalSize
+    dependencyProxyManifests { nodes { id fileName imageName digest size createdAt } }
+  }
+}`
+
+// GetDependencyProxySettings gets the dependency proxy settings, the cleanup
+// policy and the cached manifests of the group with the given full path.
+//
+// The REST API doesn't expose these settings, so they are read using the
+// GraphQL API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/graphql/reference/#group
+func (s *DependencyProxyService) GetDependencyProxySettings(fullPath string, options ...RequestOptionFunc) (*DependencyProxySettings, *Response, error) {
+	var out struct {
+		Group *struct {
+			DependencyProxySetting *struct {
+				Enabled bool `json:"enabled"`
+			} `json:"dependencyProxySetting"`
+			DependencyProxyImageTTLPolicy *struct {
+				Enabled   bool       `json:"enabled"`
+				TTL       int        `json:"ttl"`
+				CreatedAt *time.Time `json:"createdAt"`
+				UpdatedAt *time.Time `json:"updatedAt"`
+			} `json:"dependencyProxyImageTtlPolicy"`
+			DependencyProxyBlobCount  int    `json:"dependencyProxyBlobCount"`
+			DependencyProxyImageCount int    `json:"dependencyProxyImageCount"`
+			DependencyProxyTotalSize  string `json:"dependencyProxyTotalSize"`
+			DependencyProxyManifests  struct {
+				Nodes []struct {
+					ID        string     `json:"id"`
+					FileName  string     `json:"fileName"`
+					ImageName string     `json:"imageName"`
+					Digest    string     `json:"digest"`
+					Size      string     `json:"size"`
+					CreatedAt *time.Time `json:"createdAt"`
+				} `json:"nodes"`
+			} `json:"dependencyProxyManifests"`
+		} `json:"group"`
+	}
+
+	vars := map[string]interface{}{"fullPath": fullPath}
+	resp, err := s.client.GraphQL(context.Background(), dependencyProxySettingsQuery, vars, &out, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+	if out.Group == nil {
+		return nil, resp, fmt.Errorf("group %s not found", fullPath)
+	}
+
+	g := out.Group
+	ds := &DependencyProxySettings{
+		BlobCount:  g.DependencyProxyBlobCount,
+		ImageCount: g.DependencyProxyImageCount,
+		TotalSize:  g.DependencyProxyTotalSize,
+	}
+	if g.DependencyProxySetting != nil {
+		ds.Enabled = g.DependencyProxySetting.Enabled
+	}
+	if p := g.DependencyProxyImageTTLPolicy; p != nil {
+		ds.ImageTTLPolicy = &DependencyProxyImageTTLPolicy{
+			Enabled:   p.Enabled,
+			TTL:       p.TTL,
+			CreatedAt: p.CreatedAt,
+			UpdatedAt: p.UpdatedAt,
+		}
+	}
+	for _, m := range g.DependencyProxyManifests.Nodes {
+		ds.Manifests = append(ds.Manifests, &DependencyProxyManifest{
+			ID:        m.ID,
+			FileName:  m.FileName,
+			ImageName: m.ImageName,
+			Digest:    m.Digest,
+			Size:      m.Size,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	return ds, resp, nil
+}
@@ -0,0 +1,74 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurgeDependencyProxyCache(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/dependency_proxy/cache", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	resp, err := client.DependencyProxy.PurgeDependencyProxyCache(1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestGetDependencyProxySettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data": {"group": {
+			"dependencyProxySetting": {"enabled": true},
+			"dependencyProxyImageTtlPolicy": {"enabled": true, "ttl": 90},
+			"dependencyProxyBlobCount": 2,
+			"dependencyProxyImageCount": 1,
+			"dependencyProxyTotalSize": "1.2 MiB",
+			"dependencyProxyManifests": {"nodes": [
+				{"id": "gid://gitlab/DependencyProxy::Manifest/1", "fileName": "alpine:latest.json", "imageName": "alpine", "digest": "sha256:abc", "size": "2.1 KiB"}
+			]}
+		}}}`)
+	})
+
+	ds, _, err := client.DependencyProxy.GetDependencyProxySettings("my-group")
+	require.NoError(t, err)
+
+	want := &DependencyProxySettings{
+		Enabled:        true,
+		ImageTTLPolicy: &DependencyProxyImageTTLPolicy{Enabled: true, TTL: 90},
+		BlobCount:      2,
+		ImageCount:     1,
+		TotalSize:      "1.2 MiB",
+		Manifests: []*DependencyProxyManifest{{
+			ID:        "gid://gitlab/DependencyProxy::Manifest/1",
+			FileName:  "alpine:latest.json",
+			ImageName: "alpine",
+			Digest:    "sha256:abc",
+			Size:      "2.1 KiB",
+		}},
+	}
+	assert.Equal(t, want, ds)
+}
+
+func TestGetDependencyProxySettingsGroupNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"group": null}}`)
+	})
+
+	_, _, err := client.DependencyProxy.GetDependencyProxySettings("missing")
+	assert.EqualError(t, err, "group missing not found")
+}
@@ -152,6 +152,31 @@ func TestCreateProjectDeployToken(t *testing.T) {
 	}
 }
 
+func TestGetProjectDeployToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/deploy_tokens/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "name": "MyToken", "username": "gitlab+deploy-token-1", "expires_at": null, "revoked": false, "expired": false, "scopes": ["read_repository", "read_registry"]}`)
+	})
+
+	deployToken, _, err := client.DeployTokens.GetProjectDeployToken(5, 1)
+	if err != nil {
+		t.Errorf("DeployTokens.GetProjectDeployToken returned an error: %v", err)
+	}
+
+	want := &DeployToken{
+		ID:       1,
+		Name:     "MyToken",
+		Username: "gitlab+deploy-token-1",
+		Scopes:   []string{"read_repository", "read_registry"},
+	}
+	if !reflect.DeepEqual(want, deployToken) {
+		t.Errorf("DeployTokens.GetProjectDeployToken returned %+v, want %+v", deployToken, want)
+	}
+}
+
 func TestDeleteProjectDeployToken(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -271,6 +296,32 @@ func TestCreateGroupDeployToken(t *testing.T) {
 	}
 }
 
+func TestGetGroupDeployToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/10/deploy_tokens/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "name": "MyGroupToken", "username": "gitlab+deploy-token-2", "revoked": true, "expired": false, "scopes": ["read_package_registry"]}`)
+	})
+
+	deployToken, _, err := client.DeployTokens.GetGroupDeployToken(10, 2)
+	if err != nil {
+		t.Errorf("DeployTokens.GetGroupDeployToken returned an error: %v", err)
+	}
+
+	want := &DeployToken{
+		ID:       2,
+		Name:     "MyGroupToken",
+		Username: "gitlab+deploy-token-2",
+		Revoked:  true,
+		Scopes:   []string{"read_package_registry"},
+	}
+	if !reflect.DeepEqual(want, deployToken) {
+		t.Errorf("DeployTokens.GetGroupDeployToken returned %+v, want %+v", deployToken, want)
+	}
+}
+
 func TestDeleteGroupDeployToken(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
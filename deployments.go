@@ -34,6 +34,7 @@ type Deployment struct {
 	IID         int          `json:"iid"`
 	Ref         string       `json:"ref"`
 	SHA         string       `json:"sha"`
+	Status      string       `json:"status"`
 	CreatedAt   *time.Time   `json:"created_at"`
 	UpdatedAt   *time.Time   `json:"updated_at"`
 	User        *ProjectUser `json:"user"`
@@ -71,7 +72,7 @@ type ListProjectDeploymentsOptions struct {
 	OrderBy       *string    `url:"order_by,omitempty" json:"order_by,omitempty"`
 	Sort          *string    `url:"sort,omitempty" json:"sort,omitempty"`
 	UpdatedAfter  *time.Time `url:"updated_after,omitempty" json:"updated_after,omitempty"`
-	UpdatedBefore *time.Time `url:"update_before,omitempty" json:"updated_before,omitempty"`
+	UpdatedBefore *time.Time `url:"updated_before,omitempty" json:"updated_before,omitempty"`
 	Environment   *string    `url:"environment,omitempty" json:"environment,omitempty"`
 	Status        *string    `url:"status,omitempty" json:"status,omitempty"`
 }
@@ -168,7 +169,9 @@ type UpdateProjectDeploymentOptions struct {
 	Status *DeploymentStatusValue `url:"status,omitempty" json:"status,omitempty"`
 }
 
-// UpdateProjectDeployment updates a project deployment.
+// UpdateProjectDeployment updates a project deployment. Only deployments
+// created through the API can be updated, typically to transition them to
+// success, failed or canceled once an external deployer is done.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/deployments.html#updating-a-deployment
 func (s *DeploymentsService) UpdateProjectDeployment(pid interface{}, deployment int, opt *UpdateProjectDeploymentOptions, options ...RequestOptionFunc) (*Deployment, *Response, error) {
@@ -0,0 +1,59 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateProjectDeployment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"status":"success"}`)
+		fmt.Fprint(w, `{"id":42,"iid":2,"ref":"main","sha":"a91957a858320c0e17f3a0eca7cfacbff50ea29a","status":"success"}`)
+	})
+
+	opt := &UpdateProjectDeploymentOptions{Status: DeploymentStatus(DeploymentStatusSuccess)}
+	deployment, _, err := client.Deployments.UpdateProjectDeployment(1, 42, opt)
+	require.NoError(t, err)
+
+	want := &Deployment{
+		ID:     42,
+		IID:    2,
+		Ref:    "main",
+		SHA:    "a91957a858320c0e17f3a0eca7cfacbff50ea29a",
+		Status: "success",
+	}
+	assert.Equal(t, want, deployment)
+}
+
+func TestListProjectDeploymentsByEnvironment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/deployments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/deployments?environment=review%2Ffix-foo&order_by=created_at&sort=desc&updated_before=2021-03-01T00%3A00%3A00Z")
+		fmt.Fprint(w, `[{"id":42,"status":"success"}]`)
+	})
+
+	updatedBefore := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListProjectDeploymentsOptions{
+		OrderBy:       String("created_at"),
+		Sort:          String("desc"),
+		UpdatedBefore: &updatedBefore,
+		Environment:   String("review/fix-foo"),
+	}
+	deployments, _, err := client.Deployments.ListProjectDeployments(1, opt)
+	require.NoError(t, err)
+
+	want := []*Deployment{{ID: 42, Status: "success"}}
+	assert.Equal(t, want, deployments)
+}
@@ -0,0 +1,88 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMergeRequestDiffDiscussion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/11/discussions", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"body":"Is this right?","position":{"base_sha":"aaa","start_sha":"bbb","head_sha":"ccc","position_type":"text","new_path":"main.go","new_line":18,"old_path":"main.go","line_range":{"start":{"line_code":"abc_0_16","type":"new","new_line":16},"end":{"line_code":"abc_0_18","type":"new","new_line":18}}}}`)
+		fmt.Fprint(w, `{
+			"id": "6a9c1750b37d513a43987b574953fceb50b03ce7",
+			"individual_note": false,
+			"notes": [{
+				"id": 1128,
+				"type": "DiffNote",
+				"body": "Is this right?",
+				"position": {
+					"base_sha": "aaa",
+					"start_sha": "bbb",
+					"head_sha": "ccc",
+					"position_type": "text",
+					"new_path": "main.go",
+					"new_line": 18,
+					"old_path": "main.go"
+				}
+			}]
+		}`)
+	})
+
+	opt := &CreateMergeRequestDiscussionOptions{
+		Body: String("Is this right?"),
+		Position: &NotePosition{
+			BaseSHA:      "aaa",
+			StartSHA:     "bbb",
+			HeadSHA:      "ccc",
+			PositionType: "text",
+			NewPath:      "main.go",
+			NewLine:      18,
+			OldPath:      "main.go",
+			LineRange: &NoteLineRange{
+				Start: &NoteLinePosition{LineCode: "abc_0_16", Type: "new", NewLine: 16},
+				End:   &NoteLinePosition{LineCode: "abc_0_18", Type: "new", NewLine: 18},
+			},
+		},
+	}
+	discussion, _, err := client.Discussions.CreateMergeRequestDiscussion(1, 11, opt)
+	require.NoError(t, err)
+
+	assert.Equal(t, "6a9c1750b37d513a43987b574953fceb50b03ce7", discussion.ID)
+	require.Len(t, discussion.Notes, 1)
+	want := &NotePosition{
+		BaseSHA:      "aaa",
+		StartSHA:     "bbb",
+		HeadSHA:      "ccc",
+		PositionType: "text",
+		NewPath:      "main.go",
+		NewLine:      18,
+		OldPath:      "main.go",
+	}
+	assert.Equal(t, want, discussion.Notes[0].Position)
+}
+
+func TestResolveMergeRequestDiscussion(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/11/discussions/6a9c1750b37d513a43987b574953fceb50b03ce7", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"resolved":true}`)
+		fmt.Fprint(w, `{"id": "6a9c1750b37d513a43987b574953fceb50b03ce7", "notes": [{"id": 1128, "resolved": true}]}`)
+	})
+
+	opt := &ResolveMergeRequestDiscussionOptions{Resolved: Bool(true)}
+	discussion, _, err := client.Discussions.ResolveMergeRequestDiscussion(1, 11, "6a9c1750b37d513a43987b574953fceb50b03ce7", opt)
+	require.NoError(t, err)
+
+	require.Len(t, discussion.Notes, 1)
+	assert.True(t, discussion.Notes[0].Resolved)
+}
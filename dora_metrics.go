@@ -0,0 +1,87 @@
+package gitlab
+
+import "fmt"
+
+// DORAMetricsService handles communication with the DORA metrics related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dora/metrics.html
+type DORAMetricsService struct {
+	client *Client
+}
+
+// DORAMetric represents a single DORA metric data point. Value is the
+// aggregated value of the metric for the interval starting at Date.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/dora/metrics.html
+type DORAMetric struct {
+	Date  string  `json:"date"`
+	Value float64 `json:"value"`
+}
+
+func (m DORAMetric) String() string {
+	return Stringify(m)
+}
+
+// GetDORAMetricsOptions represents the available GetProjectDORAMetrics() and
+// GetGroupDORAMetrics() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dora/metrics.html#get-project-level-dora-metrics
+type GetDORAMetricsOptions struct {
+	Metric           *DORAMetricTypeValue     `url:"metric,omitempty" json:"metric,omitempty"`
+	StartDate        *ISOTime                 `url:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate          *ISOTime                 `url:"end_date,omitempty" json:"end_date,omitempty"`
+	Interval         *DORAMetricIntervalValue `url:"interval,omitempty" json:"interval,omitempty"`
+	EnvironmentTiers []string                 `url:"environment_tiers[],omitempty" json:"environment_tiers,omitempty"`
+}
+
+// GetProjectDORAMetrics gets the DORA metrics of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dora/metrics.html#get-project-level-dora-metrics
+func (s *DORAMetricsService) GetProjectDORAMetrics(pid interface{}, opt *GetDORAMetricsOptions, options ...RequestOptionFunc) ([]*DORAMetric, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/dora/metrics", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ms []*DORAMetric
+	resp, err := s.client.Do(req, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+// GetGroupDORAMetrics gets the DORA metrics of a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/dora/metrics.html#get-group-level-dora-metrics
+func (s *DORAMetricsService) GetGroupDORAMetrics(gid interface{}, opt *GetDORAMetricsOptions, options ...RequestOptionFunc) ([]*DORAMetric, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/dora/metrics", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ms []*DORAMetric
+	resp, err := s.client.Do(req, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
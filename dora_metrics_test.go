@@ -0,0 +1,61 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectDORAMetrics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/dora/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/dora/metrics?end_date=2021-03-31&environment_tiers%5B%5D=production&environment_tiers%5B%5D=staging&interval=monthly&metric=deployment_frequency&start_date=2021-03-01")
+		fmt.Fprint(w, `[{"date":"2021-03-01","value":3},{"date":"2021-03-02","value":6}]`)
+	})
+
+	startDate := ISOTime(time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC))
+	endDate := ISOTime(time.Date(2021, time.March, 31, 0, 0, 0, 0, time.UTC))
+	opt := &GetDORAMetricsOptions{
+		Metric:           DORAMetricType(DORAMetricDeploymentFrequency),
+		StartDate:        &startDate,
+		EndDate:          &endDate,
+		Interval:         DORAMetricInterval(DORAMetricIntervalMonthly),
+		EnvironmentTiers: []string{"production", "staging"},
+	}
+	metrics, _, err := client.DORAMetrics.GetProjectDORAMetrics(1, opt)
+	require.NoError(t, err)
+
+	want := []*DORAMetric{
+		{Date: "2021-03-01", Value: 3},
+		{Date: "2021-03-02", Value: 6},
+	}
+	assert.Equal(t, want, metrics)
+}
+
+func TestGetGroupDORAMetrics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/dora/metrics", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/dora/metrics?interval=all&metric=change_failure_rate")
+		fmt.Fprint(w, `[{"date":"2021-03-01","value":0.25}]`)
+	})
+
+	opt := &GetDORAMetricsOptions{
+		Metric:   DORAMetricType(DORAMetricChangeFailureRate),
+		Interval: DORAMetricInterval(DORAMetricIntervalAll),
+	}
+	metrics, _, err := client.DORAMetrics.GetGroupDORAMetrics(1, opt)
+	require.NoError(t, err)
+
+	want := []*DORAMetric{{Date: "2021-03-01", Value: 0.25}}
+	assert.Equal(t, want, metrics)
+}
@@ -18,6 +18,7 @@ package gitlab
 
 import (
 	"fmt"
+	"time"
 )
 
 // EnvironmentsService handles communication with the environment related methods
@@ -32,13 +33,18 @@ type EnvironmentsService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/environments.html
 type Environment struct {
-	ID             int         `json:"id"`
-	Name           string      `json:"name"`
-	Slug           string      `json:"slug"`
-	State          string      `json:"state"`
-	ExternalURL    string      `json:"external_url"`
-	Project        *Project    `json:"project"`
-	LastDeployment *Deployment `json:"last_deployment"`
+	ID             int           `json:"id"`
+	Name           string        `json:"name"`
+	Slug           string        `json:"slug"`
+	State          string        `json:"state"`
+	Tier           string        `json:"tier"`
+	ExternalURL    string        `json:"external_url"`
+	Project        *Project      `json:"project"`
+	CreatedAt      *time.Time    `json:"created_at"`
+	UpdatedAt      *time.Time    `json:"updated_at"`
+	AutoStopAt     *time.Time    `json:"auto_stop_at"`
+	LastDeployment *Deployment   `json:"last_deployment"`
+	ClusterAgent   *ClusterAgent `json:"cluster_agent"`
 }
 
 func (env Environment) String() string {
@@ -47,9 +53,17 @@ func (env Environment) String() string {
 
 // ListEnvironmentsOptions represents the available ListEnvironments() options.
 //
+// Name and Search are mutually exclusive. States is one of "available",
+// "stopping" or "stopped".
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#list-environments
-type ListEnvironmentsOptions ListOptions
+type ListEnvironmentsOptions struct {
+	ListOptions
+	Name   *string `url:"name,omitempty" json:"name,omitempty"`
+	Search *string `url:"search,omitempty" json:"search,omitempty"`
+	States *string `url:"states,omitempty" json:"states,omitempty"`
+}
 
 // ListEnvironments gets a list of environments from a project, sorted by name
 // alphabetically.
@@ -77,7 +91,9 @@ func (s *EnvironmentsService) ListEnvironments(pid interface{}, opts *ListEnviro
 	return envs, resp, err
 }
 
-// GetEnvironment gets a specific environment from a project.
+// GetEnvironment gets a specific environment from a project. Unlike the
+// environments returned by ListEnvironments, it includes the details of the
+// last deployment and the cluster agent of the environment.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/environments.html#get-a-specific-environment
@@ -192,21 +208,28 @@ func (s *EnvironmentsService) DeleteEnvironment(pid interface{}, environment int
 	return s.client.Do(req, nil)
 }
 
-// StopEnvironment stop an environment from a project team.
+// StopEnvironment stops an environment from a project and returns the
+// stopped environment.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/environments.html#stop-an-environment
-func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int, options ...RequestOptionFunc) (*Response, error) {
+func (s *EnvironmentsService) StopEnvironment(pid interface{}, environmentID int, options ...RequestOptionFunc) (*Environment, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/environments/%d/stop", pathEscape(project), environmentID)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	env := new(Environment)
+	resp, err := s.client.Do(req, env)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return env, resp, err
 }
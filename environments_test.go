@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,7 +22,7 @@ func TestListEnvironments(t *testing.T) {
 		fmt.Fprint(w, `[{"id": 1,"name": "review/fix-foo", "slug": "review-fix-foo-dfjre3", "external_url": "https://review-fix-foo-dfjre3.example.gitlab.com"}]`)
 	})
 
-	envs, _, err := client.Environments.ListEnvironments(1, &ListEnvironmentsOptions{Page: 1, PerPage: 10})
+	envs, _, err := client.Environments.ListEnvironments(1, &ListEnvironmentsOptions{ListOptions: ListOptions{Page: 1, PerPage: 10}})
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -52,6 +53,72 @@ func TestGetEnvironment(t *testing.T) {
 	}
 }
 
+func TestListStoppedEnvironments(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/environments?search=review&states=stopped")
+		fmt.Fprint(w, `[{"id":2,"name":"review/fix-bar","state":"stopped","tier":"development"}]`)
+	})
+
+	opt := &ListEnvironmentsOptions{Search: String("review"), States: String("stopped")}
+	envs, _, err := client.Environments.ListEnvironments(1, opt)
+	if err != nil {
+		t.Fatalf("Environments.ListEnvironments returned error: %v", err)
+	}
+
+	want := []*Environment{{ID: 2, Name: "review/fix-bar", State: "stopped", Tier: "development"}}
+	if !reflect.DeepEqual(want, envs) {
+		t.Errorf("Environments.ListEnvironments returned %+v, want %+v", envs, want)
+	}
+}
+
+func TestGetEnvironmentWithLastDeployment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/environments/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "review/fix-foo",
+			"state": "available",
+			"last_deployment": {"id": 100, "iid": 34, "ref": "fix-foo", "status": "success", "created_at": "2019-03-25T18:55:13.252Z"},
+			"cluster_agent": {"id": 1, "name": "agent-1", "config_project": {"id": 20}, "created_by_user_id": 42}
+		}`)
+	})
+
+	env, _, err := client.Environments.GetEnvironment(1, 1)
+	if err != nil {
+		t.Fatalf("Environments.GetEnvironment returned error: %v", err)
+	}
+
+	createdAt := time.Date(2019, time.March, 25, 18, 55, 13, 252000000, time.UTC)
+	want := &Environment{
+		ID:    1,
+		Name:  "review/fix-foo",
+		State: "available",
+		LastDeployment: &Deployment{
+			ID:        100,
+			IID:       34,
+			Ref:       "fix-foo",
+			Status:    "success",
+			CreatedAt: &createdAt,
+		},
+		ClusterAgent: &ClusterAgent{
+			ID:              1,
+			Name:            "agent-1",
+			ConfigProject:   &Project{ID: 20},
+			CreatedByUserID: 42,
+		},
+	}
+	if !reflect.DeepEqual(want, env) {
+		t.Errorf("Environments.GetEnvironment returned %+v, want %+v", env, want)
+	}
+}
+
 func TestCreateEnvironment(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -115,11 +182,17 @@ func TestStopEnvironment(t *testing.T) {
 	mux.HandleFunc("/api/v4/projects/1/environments/1/stop", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
 		testURL(t, r, "/api/v4/projects/1/environments/1/stop")
+		fmt.Fprint(w, `{"id":1,"name":"review/fix-foo","state":"stopped"}`)
 	})
-	_, err := client.Environments.StopEnvironment(1, 1)
+	env, _, err := client.Environments.StopEnvironment(1, 1)
 	if err != nil {
 		log.Fatal(err)
 	}
+
+	want := &Environment{ID: 1, Name: "review/fix-foo", State: "stopped"}
+	if !reflect.DeepEqual(want, env) {
+		t.Errorf("Environments.StopEnvironment returned %+v, want %+v", env, want)
+	}
 }
 
 func TestUnmarshal(t *testing.T) {
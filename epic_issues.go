@@ -70,7 +70,9 @@ func (s *EpicIssuesService) AssignEpicIssue(gid interface{}, epic, issue int, op
 	return a, resp, err
 }
 
-// RemoveEpicIssue removes an issue from an epic.
+// RemoveEpicIssue removes an issue from an epic. The epicIssue is the ID of
+// the association, as returned by AssignEpicIssue or in the EpicIssueID of
+// the issues listed by ListEpicIssues.
 //
 // Gitlab API Docs:
 // https://docs.gitlab.com/ee/api/epic_issues.html#remove-an-issue-from-the-epic
@@ -0,0 +1,94 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListEpicIssues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/7/epics/8/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":76,"iid":6,"project_id":8,"epic_issue_id":2}]`)
+	})
+
+	issues, _, err := client.EpicIssues.ListEpicIssues(7, 8, nil)
+	if err != nil {
+		t.Fatalf("EpicIssues.ListEpicIssues returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: 76, IID: 6, ProjectID: 8, EpicIssueID: 2}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("EpicIssues.ListEpicIssues returned %+v, want %+v", issues, want)
+	}
+}
+
+func TestAssignEpicIssue(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/7/epics/8/issues/76", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":11,"epic":{"id":30,"iid":8},"issue":{"id":76,"iid":6}}`)
+	})
+
+	a, _, err := client.EpicIssues.AssignEpicIssue(7, 8, 76)
+	if err != nil {
+		t.Fatalf("EpicIssues.AssignEpicIssue returned error: %v", err)
+	}
+
+	want := &EpicIssueAssignment{
+		ID:    11,
+		Epic:  &Epic{ID: 30, IID: 8},
+		Issue: &Issue{ID: 76, IID: 6},
+	}
+	if !reflect.DeepEqual(want, a) {
+		t.Errorf("EpicIssues.AssignEpicIssue returned %+v, want %+v", a, want)
+	}
+}
+
+func TestRemoveEpicIssue(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/7/epics/8/issues/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"id":11,"epic":{"id":30,"iid":8},"issue":{"id":76,"iid":6}}`)
+	})
+
+	a, _, err := client.EpicIssues.RemoveEpicIssue(7, 8, 11)
+	if err != nil {
+		t.Fatalf("EpicIssues.RemoveEpicIssue returned error: %v", err)
+	}
+
+	if a.ID != 11 || a.Issue == nil || a.Issue.ID != 76 {
+		t.Errorf("EpicIssues.RemoveEpicIssue returned %+v", a)
+	}
+}
+
+func TestUpdateEpicIssueAssignment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/7/epics/8/issues/11", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"move_before_id":12}`)
+		fmt.Fprint(w, `[{"id":77,"epic_issue_id":12},{"id":76,"epic_issue_id":11}]`)
+	})
+
+	opt := &UpdateEpicIsssueAssignmentOptions{MoveBeforeID: Int(12)}
+	issues, _, err := client.EpicIssues.UpdateEpicIssueAssignment(7, 8, 11, opt)
+	if err != nil {
+		t.Fatalf("EpicIssues.UpdateEpicIssueAssignment returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: 77, EpicIssueID: 12}, {ID: 76, EpicIssueID: 11}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("EpicIssues.UpdateEpicIssueAssignment returned %+v, want %+v", issues, want)
+	}
+}
@@ -0,0 +1,112 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetErrorTrackingSettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/error_tracking/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"active": true,
+			"project_name": "sample sentry project",
+			"sentry_external_url": "https://sentry.io/myawesomeproject/project",
+			"api_url": "https://sentry.io/api/1/projects/myawesomeproject/project",
+			"integrated": false
+		}`)
+	})
+
+	ets, _, err := client.ErrorTracking.GetErrorTrackingSettings(1)
+	require.NoError(t, err)
+
+	want := &ErrorTrackingSettings{
+		Active:            true,
+		ProjectName:       "sample sentry project",
+		SentryExternalURL: "https://sentry.io/myawesomeproject/project",
+		APIURL:            "https://sentry.io/api/1/projects/myawesomeproject/project",
+	}
+	assert.Equal(t, want, ets)
+}
+
+func TestEnableDisableErrorTracking(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/error_tracking/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testURL(t, r, "/api/v4/projects/1/error_tracking/settings")
+		testBody(t, r, `{"active":true,"integrated":true}`)
+		fmt.Fprint(w, `{"active": true, "integrated": true}`)
+	})
+
+	opt := &EnableDisableErrorTrackingOptions{Active: Bool(true), Integrated: Bool(true)}
+	ets, _, err := client.ErrorTracking.EnableDisableErrorTracking(1, opt)
+	require.NoError(t, err)
+
+	assert.Equal(t, &ErrorTrackingSettings{Active: true, Integrated: true}, ets)
+}
+
+func TestListClientKeys(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/error_tracking/client_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{
+				"id": 1,
+				"active": true,
+				"public_key": "glet_aa77551d849c083f76d0bc545ed053a3",
+				"sentry_dsn": "https://glet_aa77551d849c083f76d0bc545ed053a3@gitlab.example.com/api/v4/error_tracking/collector/5"
+			}
+		]`)
+	})
+
+	cks, _, err := client.ErrorTracking.ListClientKeys(1, &ListClientKeysOptions{Page: 1, PerPage: 10})
+	require.NoError(t, err)
+
+	want := []*ErrorTrackingClientKey{{
+		ID:        1,
+		Active:    true,
+		PublicKey: "glet_aa77551d849c083f76d0bc545ed053a3",
+		SentryDsn: "https://glet_aa77551d849c083f76d0bc545ed053a3@gitlab.example.com/api/v4/error_tracking/collector/5",
+	}}
+	assert.Equal(t, want, cks)
+}
+
+func TestCreateClientKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/error_tracking/client_keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 3, "active": true, "public_key": "glet_10a7dbb0aa0009d10f01fd0ece3a4d93"}`)
+	})
+
+	ck, _, err := client.ErrorTracking.CreateClientKey(1)
+	require.NoError(t, err)
+
+	assert.Equal(t, &ErrorTrackingClientKey{ID: 3, Active: true, PublicKey: "glet_10a7dbb0aa0009d10f01fd0ece3a4d93"}, ck)
+}
+
+func TestDeleteClientKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/error_tracking/client_keys/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.ErrorTracking.DeleteClientKey(1, 3)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -1,9 +1,23 @@
 package gitlab
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
+)
+
+var (
+	// ErrInvalidHookToken is returned when the secret token of a hook request
+	// doesn't match the expected secret token.
+	ErrInvalidHookToken = errors.New("Invalid hook token")
 )
 
 // EventType represents a Gitlab event type.
@@ -39,13 +53,115 @@ type noteEvent struct {
 	} `json:"object_attributes"`
 }
 
-const eventTypeHeader = "X-Gitlab-Event"
+const (
+	eventTypeHeader      = "X-Gitlab-Event"
+	eventTokenHeader     = "X-Gitlab-Token"
+	eventSignatureHeader = "X-Gitlab-Signature"
+)
 
 // HookEventType returns the event type for the given request.
 func HookEventType(r *http.Request) EventType {
 	return EventType(r.Header.Get(eventTypeHeader))
 }
 
+// ValidateWebhookToken reports whether the given request is authenticated
+// by the given secret. The secret token in the X-Gitlab-Token header is
+// compared in constant time, to prevent timing attacks. If the request is
+// signed, the X-Gitlab-Signature header must also contain the hex encoded
+// HMAC-SHA256 of the payload, optionally prefixed with "sha256=". The body
+// of the request is restored after reading it, so it can still be parsed.
+//
+// False is returned when the request contains neither header, or when the
+// secret is empty.
+func ValidateWebhookToken(r *http.Request, secret string) bool {
+	if secret == "" {
+		return false
+	}
+
+	token := r.Header.Get(eventTokenHeader)
+	signature := r.Header.Get(eventSignatureHeader)
+	if token == "" && signature == "" {
+		return false
+	}
+
+	if token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return false
+	}
+
+	if signature != "" {
+		want, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+		if err != nil || r.Body == nil {
+			return false
+		}
+
+		payload, err := ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return false
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(payload))
+
+		mac := hmac.New(sha256.New, []byte(secret))
+		mac.Write(payload)
+		if !hmac.Equal(mac.Sum(nil), want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateHookToken checks if the given request is authenticated by the given
+// secret using ValidateWebhookToken. ErrInvalidHookToken is returned if it
+// isn't.
+//
+// When no secret token is configured for the hook, GitLab doesn't send a
+// token at all, so in that case the secret should be empty. An empty secret
+// only accepts requests without a token and signature.
+func ValidateHookToken(r *http.Request, secret string) error {
+	if secret == "" {
+		if r.Header.Get(eventTokenHeader) != "" || r.Header.Get(eventSignatureHeader) != "" {
+			return ErrInvalidHookToken
+		}
+		return nil
+	}
+	if !ValidateWebhookToken(r, secret) {
+		return ErrInvalidHookToken
+	}
+	return nil
+}
+
+// ParseHookRequest validates the given request using ValidateHookToken,
+// reads its payload and parses both web- and system hooks.
+// ErrInvalidHookToken is returned if the request isn't authenticated by the
+// given secret.
+//
+// Example usage:
+//
+// func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//     event, err := gitlab.ParseHookRequest(r, s.secret)
+//     if err == gitlab.ErrInvalidHookToken { ... }
+//     if err != nil { ... }
+//     switch event := event.(type) {
+//     case *gitlab.PushEvent:
+//         processPushEvent(event)
+//     ...
+//     }
+// }
+//
+func ParseHookRequest(r *http.Request, secret string) (event interface{}, err error) {
+	if err := ValidateHookToken(r, secret); err != nil {
+		return nil, err
+	}
+
+	payload, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseHook(HookEventType(r), payload)
+}
+
 // ParseHook tries to parse both web- and system hooks.
 //
 // Example usage:
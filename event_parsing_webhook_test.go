@@ -1,7 +1,13 @@
 package gitlab
 
 import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,6 +26,152 @@ func TestWebhookEventType(t *testing.T) {
 	}
 }
 
+func TestValidateWebhookToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", nil)
+	if err != nil {
+		t.Errorf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Token", "secret")
+
+	if !ValidateWebhookToken(req, "secret") {
+		t.Errorf("ValidateWebhookToken returned false, want true")
+	}
+	if ValidateWebhookToken(req, "other") {
+		t.Errorf("ValidateWebhookToken returned true for a wrong secret")
+	}
+	if ValidateWebhookToken(req, "") {
+		t.Errorf("ValidateWebhookToken returned true for an empty secret")
+	}
+}
+
+func TestValidateWebhookTokenMissingHeaders(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", nil)
+	if err != nil {
+		t.Errorf("Error creating HTTP request: %s", err)
+	}
+
+	if ValidateWebhookToken(req, "secret") {
+		t.Errorf("ValidateWebhookToken returned true for a request without token")
+	}
+}
+
+func TestValidateWebhookTokenSignature(t *testing.T) {
+	payload := []byte(`{"object_kind":"push"}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write(payload)
+	signature := hex.EncodeToString(mac.Sum(nil))
+
+	tests := []struct {
+		signature string
+		want      bool
+	}{
+		{signature, true},
+		{"sha256=" + signature, true},
+		{strings.Repeat("0", len(signature)), false},
+		{"not-hex", false},
+	}
+
+	for _, tt := range tests {
+		req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", bytes.NewReader(payload))
+		if err != nil {
+			t.Errorf("Error creating HTTP request: %s", err)
+		}
+		req.Header.Set("X-Gitlab-Signature", tt.signature)
+
+		if got := ValidateWebhookToken(req, "secret"); got != tt.want {
+			t.Errorf("ValidateWebhookToken with signature %q returned %v, want %v", tt.signature, got, tt.want)
+		}
+
+		// The body must still be readable after validating the signature.
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("Error reading body: %s", err)
+		}
+		if tt.want && !bytes.Equal(body, payload) {
+			t.Errorf("Body is %s after validation, want %s", body, payload)
+		}
+	}
+}
+
+func TestValidateHookToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", nil)
+	if err != nil {
+		t.Errorf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Token", "secret")
+
+	if err := ValidateHookToken(req, "secret"); err != nil {
+		t.Errorf("ValidateHookToken returned error: %v", err)
+	}
+	if err := ValidateHookToken(req, "other"); err != ErrInvalidHookToken {
+		t.Errorf("ValidateHookToken returned %v, want %v", err, ErrInvalidHookToken)
+	}
+	if err := ValidateHookToken(req, ""); err != ErrInvalidHookToken {
+		t.Errorf("ValidateHookToken returned %v, want %v", err, ErrInvalidHookToken)
+	}
+
+	req.Header.Del("X-Gitlab-Token")
+	if err := ValidateHookToken(req, ""); err != nil {
+		t.Errorf("ValidateHookToken returned error: %v", err)
+	}
+	if err := ValidateHookToken(req, "secret"); err != ErrInvalidHookToken {
+		t.Errorf("ValidateHookToken returned %v, want %v", err, ErrInvalidHookToken)
+	}
+}
+
+func TestParseHookRequest(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+	if err != nil {
+		t.Errorf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Token", "secret")
+
+	parsedEvent, err := ParseHookRequest(req, "secret")
+	if err != nil {
+		t.Fatalf("Error parsing hook request: %s", err)
+	}
+
+	event, ok := parsedEvent.(*PushEvent)
+	if !ok {
+		t.Fatalf("Expected PushEvent, but parsing produced %T", parsedEvent)
+	}
+	assert.Equal(t, 15, event.ProjectID)
+}
+
+func TestParseHookRequestWithoutSecret(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+	if err != nil {
+		t.Errorf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+
+	parsedEvent, err := ParseHookRequest(req, "")
+	if err != nil {
+		t.Fatalf("Error parsing hook request: %s", err)
+	}
+	if _, ok := parsedEvent.(*PushEvent); !ok {
+		t.Fatalf("Expected PushEvent, but parsing produced %T", parsedEvent)
+	}
+}
+
+func TestParseHookRequestInvalidToken(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPost, "https://gitlab.com", bytes.NewReader(loadFixture("testdata/webhooks/push.json")))
+	if err != nil {
+		t.Errorf("Error creating HTTP request: %s", err)
+	}
+	req.Header.Set("X-Gitlab-Event", "Push Hook")
+	req.Header.Set("X-Gitlab-Token", "wrong")
+
+	event, err := ParseHookRequest(req, "secret")
+	if err != ErrInvalidHookToken {
+		t.Errorf("ParseHookRequest returned error %v, want %v", err, ErrInvalidHookToken)
+	}
+	if event != nil {
+		t.Errorf("ParseHookRequest returned %+v, want nil", event)
+	}
+}
+
 func TestParsePushHook(t *testing.T) {
 	raw := loadFixture("testdata/webhooks/push.json")
 
@@ -34,6 +34,7 @@ type EventsService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/events.html#get-user-contribution-events
 type ContributionEvent struct {
+	ID          int        `json:"id"`
 	Title       string     `json:"title"`
 	ProjectID   int        `json:"project_id"`
 	ActionName  string     `json:"action_name"`
@@ -75,6 +76,7 @@ type ListContributionEventsOptions struct {
 	Before     *ISOTime              `url:"before,omitempty" json:"before,omitempty"`
 	After      *ISOTime              `url:"after,omitempty" json:"after,omitempty"`
 	Sort       *string               `url:"sort,omitempty" json:"sort,omitempty"`
+	Scope      *string               `url:"scope,omitempty" json:"scope,omitempty"`
 }
 
 // ListUserContributionEvents retrieves user contribution events
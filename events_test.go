@@ -0,0 +1,116 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCurrentUserContributionEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/events?action=pushed&after=2019-01-01&before=2019-02-01&scope=all&sort=asc")
+		fmt.Fprint(w, `[
+			{
+				"id": 4,
+				"title": null,
+				"project_id": 15,
+				"action_name": "pushed to",
+				"target_id": null,
+				"target_type": null,
+				"author_id": 1,
+				"created_at": "2019-01-10T13:24:58.530Z",
+				"push_data": {
+					"commit_count": 1,
+					"action": "pushed",
+					"ref_type": "branch",
+					"commit_from": "50d4420237a9de7be1304607147aec22e4a14af7",
+					"commit_to": "c5feabde2d8cd023215af4d2ceeb7a64839fc428",
+					"ref": "main",
+					"commit_title": "Add simple search to projects in public area"
+				},
+				"author_username": "root"
+			}
+		]`)
+	})
+
+	after := ISOTime(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC))
+	before := ISOTime(time.Date(2019, time.February, 1, 0, 0, 0, 0, time.UTC))
+	action := PushedEventType
+	opt := &ListContributionEventsOptions{
+		Action: &action,
+		After:  &after,
+		Before: &before,
+		Sort:   String("asc"),
+		Scope:  String("all"),
+	}
+	events, _, err := client.Events.ListCurrentUserContributionEvents(opt)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	event := events[0]
+	assert.Equal(t, 4, event.ID)
+	assert.Equal(t, "pushed to", event.ActionName)
+	assert.Equal(t, "root", event.AuthorUsername)
+	assert.Equal(t, 1, event.PushData.CommitCount)
+	assert.Equal(t, "main", event.PushData.Ref)
+	assert.Equal(t, "c5feabde2d8cd023215af4d2ceeb7a64839fc428", event.PushData.CommitTo)
+}
+
+func TestListProjectVisibleEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/15/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/15/events?target_type=note")
+		fmt.Fprint(w, `[
+			{
+				"id": 5,
+				"project_id": 15,
+				"action_name": "commented on",
+				"target_id": 1312,
+				"target_iid": 1312,
+				"target_type": "Note",
+				"author_id": 1,
+				"target_title": null,
+				"note": {"id": 1312, "body": "What an awesome day!", "noteable_id": 377, "noteable_type": "Issue"},
+				"author_username": "root"
+			}
+		]`)
+	})
+
+	opt := &ListContributionEventsOptions{TargetType: EventTargetType(NoteEventTargetType)}
+	events, _, err := client.Events.ListProjectVisibleEvents(15, opt)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, "Note", events[0].TargetType)
+	require.NotNil(t, events[0].Note)
+	assert.Equal(t, "What an awesome day!", events[0].Note.Body)
+	assert.Equal(t, "Issue", events[0].Note.NoteableType)
+}
+
+func TestListUserContributionEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/users/1/events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 6, "project_id": 2, "action_name": "opened", "target_id": 830, "target_iid": 82, "target_type": "Issue", "target_title": "Public project search field"}]`)
+	})
+
+	events, _, err := client.Users.ListUserContributionEvents(1, nil)
+	require.NoError(t, err)
+
+	require.Len(t, events, 1)
+	assert.Equal(t, 82, events[0].TargetIID)
+	assert.Equal(t, "Public project search field", events[0].TargetTitle)
+}
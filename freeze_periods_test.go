@@ -0,0 +1,138 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFreezePeriods(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"freeze_start": "0 23 * * 5",
+			"freeze_end": "0 8 * * 1",
+			"cron_timezone": "UTC",
+			"created_at": "2020-05-15T17:03:35.702Z",
+			"updated_at": "2020-05-15T17:06:41.566Z"
+		}]`)
+	})
+
+	periods, _, err := client.FreezePeriods.ListFreezePeriods(19, nil)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2020, time.May, 15, 17, 3, 35, 702000000, time.UTC)
+	updatedAt := time.Date(2020, time.May, 15, 17, 6, 41, 566000000, time.UTC)
+	want := []*FreezePeriod{{
+		ID:           1,
+		FreezeStart:  "0 23 * * 5",
+		FreezeEnd:    "0 8 * * 1",
+		CronTimezone: "UTC",
+		CreatedAt:    &createdAt,
+		UpdatedAt:    &updatedAt,
+	}}
+	assert.Equal(t, want, periods)
+}
+
+func TestGetFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "freeze_start": "0 23 * * 5", "freeze_end": "0 8 * * 1", "cron_timezone": "UTC"}`)
+	})
+
+	period, _, err := client.FreezePeriods.GetFreezePeriod(19, 1)
+	require.NoError(t, err)
+
+	want := &FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 8 * * 1", CronTimezone: "UTC"}
+	assert.Equal(t, want, period)
+}
+
+func TestCreateFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"freeze_start":"0 23 * * 5","freeze_end":"0 7 * * 1","cron_timezone":"UTC"}`)
+		fmt.Fprint(w, `{"id": 1, "freeze_start": "0 23 * * 5", "freeze_end": "0 7 * * 1", "cron_timezone": "UTC"}`)
+	})
+
+	opt := &CreateFreezePeriodOptions{
+		FreezeStart:  String("0 23 * * 5"),
+		FreezeEnd:    String("0 7 * * 1"),
+		CronTimezone: String("UTC"),
+	}
+	period, _, err := client.FreezePeriods.CreateFreezePeriod(19, opt)
+	require.NoError(t, err)
+
+	want := &FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 7 * * 1", CronTimezone: "UTC"}
+	assert.Equal(t, want, period)
+}
+
+func TestCreateFreezePeriodInvalidCron(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message": {"freeze_start": ["is invalid syntax"]}}`)
+	})
+
+	opt := &CreateFreezePeriodOptions{
+		FreezeStart: String("not a cron"),
+		FreezeEnd:   String("0 7 * * 1"),
+	}
+	period, resp, err := client.FreezePeriods.CreateFreezePeriod(19, opt)
+	assert.Nil(t, period)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	assert.Equal(t, "{message: {freeze_start: [is invalid syntax]}}", errResp.Message)
+}
+
+func TestUpdateFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"freeze_end":"0 8 * * 1"}`)
+		fmt.Fprint(w, `{"id": 1, "freeze_start": "0 23 * * 5", "freeze_end": "0 8 * * 1", "cron_timezone": "UTC"}`)
+	})
+
+	opt := &UpdateFreezePeriodOptions{FreezeEnd: String("0 8 * * 1")}
+	period, _, err := client.FreezePeriods.UpdateFreezePeriod(19, 1, opt)
+	require.NoError(t, err)
+
+	want := &FreezePeriod{ID: 1, FreezeStart: "0 23 * * 5", FreezeEnd: "0 8 * * 1", CronTimezone: "UTC"}
+	assert.Equal(t, want, period)
+}
+
+func TestDeleteFreezePeriod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/19/freeze_periods/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.FreezePeriods.DeleteFreezePeriod(19, 1)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
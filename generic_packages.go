@@ -0,0 +1,335 @@
+package gitlab
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// ErrChecksumMismatch is returned by PublishPackageFile when the checksum
+// reported by GitLab does not match the checksum of the uploaded content.
+var ErrChecksumMismatch = errors.New("Checksum of the uploaded package file does not match")
+
+// ErrPackageFileNotReplayable is returned by PublishPackageFile when the
+// upload needs to be retried, but the content is not an io.ReadSeeker and
+// can therefore not be sent again.
+var ErrPackageFileNotReplayable = errors.New("Package file content can not be sent again, use an io.ReadSeeker to allow retries")
+
+// GenericPackagesService handles communication with the packages related
+// methods of the GitLab API.
+//
+// GitLab docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html
+type GenericPackagesService struct {
+	client *Client
+}
+
+// GenericPackagesFile represents a GitLab generic package file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#publish-a-package-file
+type GenericPackagesFile struct {
+	ID         int        `json:"id"`
+	PackageID  int        `json:"package_id"`
+	CreatedAt  *time.Time `json:"created_at"`
+	UpdatedAt  *time.Time `json:"updated_at"`
+	Size       int        `json:"size"`
+	FileStore  int        `json:"file_store"`
+	FileMD5    string     `json:"file_md5"`
+	FileSHA1   string     `json:"file_sha1"`
+	FileSHA256 string     `json:"file_sha256"`
+	FileName   string     `json:"file_name"`
+	File       struct {
+		URL string `json:"url"`
+	} `json:"file"`
+}
+
+// PublishPackageFileOptions represents the available PublishPackageFile()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#publish-a-package-file
+type PublishPackageFileOptions struct {
+	Status *string `url:"status,omitempty" json:"status,omitempty"`
+	Select *string `url:"select,omitempty" json:"select,omitempty"`
+
+	// VerifyChecksum is a client side option. When set, the checksum of the
+	// content is calculated while it is uploaded and compared to the checksum
+	// GitLab reports for the stored package file. The publish endpoint has no
+	// parameter or header to pass an expected checksum, GitLab calculates the
+	// checksums of the stored file itself, so the comparison is done here.
+	VerifyChecksum bool `url:"-" json:"-"`
+}
+
+// PublishPackageFile uploads a file to a project's package registry. The
+// content is streamed to GitLab as it is read. If content is an io.ReadSeeker,
+// it is rewound when the upload is retried. Other readers can't be rewound, so
+// in that case a retry fails with ErrPackageFileNotReplayable.
+//
+// If opt.VerifyChecksum is set, the package file details are requested from
+// GitLab and ErrChecksumMismatch is returned when the checksums calculated by
+// GitLab don't match the checksums of the uploaded content. GitLab doesn't
+// verify an upload against a checksum provided by the client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#publish-a-package-file
+func (s *GenericPackagesService) PublishPackageFile(pid interface{}, packageName, packageVersion, fileName string, content io.Reader, opt *PublishPackageFileOptions, options ...RequestOptionFunc) (*GenericPackagesFile, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/packages/generic/%s/%s/%s",
+		pathEscape(project),
+		pathEscape(packageName),
+		pathEscape(packageVersion),
+		pathEscape(fileName),
+	)
+
+	var hashes []hash.Hash
+	if opt != nil && opt.VerifyChecksum {
+		// Make sure GitLab returns the details (including the
+		// checksums) of the stored package file.
+		o := *opt
+		o.Select = String("package_file")
+		opt = &o
+
+		hashes = []hash.Hash{sha256.New(), md5.New()}
+	}
+
+	// An empty method makes sure the options are encoded as query parameters.
+	req, err := s.client.NewRequest("", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, size, err := packageFileBody(content, hashes...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Recreate the request with the content as its body, so the content is
+	// provided again for every attempt when the request is retried.
+	upload, err := retryablehttp.NewRequest("PUT", req.URL.String(), body)
+	if err != nil {
+		return nil, nil, err
+	}
+	upload.Request = upload.Request.WithContext(req.Context())
+	upload.Header = req.Header
+	upload.Header.Set("Content-Type", "application/octet-stream")
+	if size >= 0 {
+		upload.ContentLength = size
+	}
+	req = upload
+
+	f := new(GenericPackagesFile)
+	resp, err := s.client.Do(req, f)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if hashes != nil {
+		switch {
+		case f.FileSHA256 != "":
+			if f.FileSHA256 != hex.EncodeToString(hashes[0].Sum(nil)) {
+				return f, resp, ErrChecksumMismatch
+			}
+		case f.FileMD5 != "":
+			if f.FileMD5 != hex.EncodeToString(hashes[1].Sum(nil)) {
+				return f, resp, ErrChecksumMismatch
+			}
+		default:
+			return f, resp, fmt.Errorf("no checksum returned for package file %s", fileName)
+		}
+	}
+
+	return f, resp, err
+}
+
+// packageFileBody returns a function providing the content of a package file
+// for every attempt of the upload request, together with the size of the
+// content if it is known. An io.ReadSeeker is rewound before every attempt.
+// Any other reader can only be sent once, so a retry fails with
+// ErrPackageFileNotReplayable instead of uploading a truncated file. The given
+// hashes are reset and fed with the content of every attempt.
+func packageFileBody(content io.Reader, hashes ...hash.Hash) (retryablehttp.ReaderFunc, int64, error) {
+	withHashes := func(r io.Reader) io.Reader {
+		ws := make([]io.Writer, len(hashes))
+		for i, h := range hashes {
+			h.Reset()
+			ws[i] = h
+		}
+		// Hide any Close method of the content, as the HTTP client closes
+		// the body of every attempt.
+		return struct{ io.Reader }{io.TeeReader(r, io.MultiWriter(ws...))}
+	}
+
+	if rs, ok := content.(io.ReadSeeker); ok {
+		start, err := rs.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, -1, err
+		}
+		end, err := rs.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, -1, err
+		}
+		return func() (io.Reader, error) {
+			if _, err := rs.Seek(start, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return withHashes(rs), nil
+		}, end - start, nil
+	}
+
+	stream := &onceReader{r: content}
+	return func() (io.Reader, error) {
+		if stream.started {
+			return nil, ErrPackageFileNotReplayable
+		}
+		return withHashes(stream), nil
+	}, -1, nil
+}
+
+// onceReader records whether reading from the underlying reader has started.
+type onceReader struct {
+	r       io.Reader
+	started bool
+}
+
+func (o *onceReader) Read(p []byte) (int, error) {
+	o.started = true
+	return o.r.Read(p)
+}
+
+// DownloadPackageFile allows you to download the package file.
+//
+// The complete file is kept in memory, use DownloadPackageFileToWriter for
+// large files.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#download-package-file
+func (s *GenericPackagesService) DownloadPackageFile(pid interface{}, packageName, packageVersion, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	var f bytes.Buffer
+	resp, err := s.DownloadPackageFileToWriter(pid, packageName, packageVersion, fileName, &f, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return f.Bytes(), resp, err
+}
+
+// DownloadPackageFileToWriter downloads the package file and streams its
+// content to w as it arrives. API errors are returned as an *ErrorResponse
+// and are never written to w. The response is returned even if writing to w
+// fails, so the caller can still inspect it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#download-package-file
+func (s *GenericPackagesService) DownloadPackageFileToWriter(pid interface{}, packageName, packageVersion, fileName string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/packages/generic/%s/%s/%s",
+		pathEscape(project),
+		pathEscape(packageName),
+		pathEscape(packageVersion),
+		pathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
+// DownloadPackageFileRangeOptions represents the available
+// DownloadPackageFileRange() options.
+//
+// RangeEnd is optional, when not set the range extends to the end of the file.
+type DownloadPackageFileRangeOptions struct {
+	RangeStart int64
+	RangeEnd   *int64
+}
+
+// PackageFileRange describes the part of a package file that was sent by
+// GitLab in response to a range request.
+type PackageFileRange struct {
+	// Partial reports if the server honored the range and only sent the
+	// requested part (206 Partial Content). If false, the complete file was
+	// sent and written to the writer.
+	Partial bool
+
+	// ContentRange and AcceptRanges contain the values of the corresponding
+	// response headers.
+	ContentRange string
+	AcceptRanges string
+}
+
+// DownloadPackageFileRange downloads part of a package file by setting the
+// Range header, which allows resuming interrupted downloads. The received
+// bytes are streamed to w. Callers must check PackageFileRange.Partial, as
+// the server may choose to ignore the range and send the complete file.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/packages/generic_packages/index.html#download-package-file
+func (s *GenericPackagesService) DownloadPackageFileRange(pid interface{}, packageName, packageVersion, fileName string, w io.Writer, opt *DownloadPackageFileRangeOptions, options ...RequestOptionFunc) (*PackageFileRange, *Response, error) {
+	if opt == nil {
+		return nil, nil, fmt.Errorf("missing range options")
+	}
+	if opt.RangeStart < 0 {
+		return nil, nil, fmt.Errorf("invalid range start %d", opt.RangeStart)
+	}
+	if opt.RangeEnd != nil && *opt.RangeEnd < opt.RangeStart {
+		return nil, nil, fmt.Errorf("invalid range %d-%d", opt.RangeStart, *opt.RangeEnd)
+	}
+
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/packages/generic/%s/%s/%s",
+		pathEscape(project),
+		pathEscape(packageName),
+		pathEscape(packageVersion),
+		pathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := fmt.Sprintf("bytes=%d-", opt.RangeStart)
+	if opt.RangeEnd != nil {
+		r += strconv.FormatInt(*opt.RangeEnd, 10)
+	}
+	req.Header.Set("Range", r)
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	pr := &PackageFileRange{
+		Partial:      resp.StatusCode == http.StatusPartialContent,
+		ContentRange: resp.Header.Get("Content-Range"),
+		AcceptRanges: resp.Header.Get("Accept-Ranges"),
+	}
+
+	return pr, resp, err
+}
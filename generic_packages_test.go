@@ -0,0 +1,291 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPublishPackageFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testURL(t, r, "/api/v4/projects/1234/packages/generic/foo/0%2E1%2E2/bar-baz%2Etxt?status=hidden")
+		testBody(t, r, "bar = baz")
+		fmt.Fprint(w, `{"id": 1, "package_id": 1000, "size": 9, "file_name": "bar-baz.txt", "file_md5": null}`)
+	})
+
+	f, _, err := client.GenericPackages.PublishPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", strings.NewReader("bar = baz"), &PublishPackageFileOptions{Status: String("hidden")})
+	if err != nil {
+		t.Fatalf("GenericPackages.PublishPackageFile returned error: %v", err)
+	}
+
+	if f.PackageID != 1000 || f.FileName != "bar-baz.txt" {
+		t.Errorf("GenericPackages.PublishPackageFile returned %+v", f)
+	}
+}
+
+func TestDownloadPackageFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "bar = baz")
+	})
+
+	packageBytes, _, err := client.GenericPackages.DownloadPackageFile(1234, "foo", "0.1.2", "bar-baz.txt")
+	if err != nil {
+		t.Fatalf("GenericPackages.DownloadPackageFile returned error: %v", err)
+	}
+
+	want := []byte("bar = baz")
+	if !bytes.Equal(want, packageBytes) {
+		t.Errorf("GenericPackages.DownloadPackageFile returned %q, want %q", packageBytes, want)
+	}
+}
+
+func TestDownloadPackageFileToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "bar = baz")
+	})
+
+	var b bytes.Buffer
+	resp, err := client.GenericPackages.DownloadPackageFileToWriter(1234, "foo", "0.1.2", "bar-baz.txt", &b)
+	if err != nil {
+		t.Fatalf("GenericPackages.DownloadPackageFileToWriter returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GenericPackages.DownloadPackageFileToWriter returned status %d", resp.StatusCode)
+	}
+	if got := b.String(); got != "bar = baz" {
+		t.Errorf("GenericPackages.DownloadPackageFileToWriter wrote %q, want %q", got, "bar = baz")
+	}
+}
+
+func TestDownloadPackageFileToWriterNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Not Found"}`)
+	})
+
+	var b bytes.Buffer
+	resp, err := client.GenericPackages.DownloadPackageFileToWriter(1234, "foo", "0.1.2", "bar-baz.txt", &b)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Fatalf("GenericPackages.DownloadPackageFileToWriter returned error %v, want *ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("GenericPackages.DownloadPackageFileToWriter returned response %+v, want status 404", resp)
+	}
+	if b.Len() != 0 {
+		t.Errorf("GenericPackages.DownloadPackageFileToWriter wrote error body %q to writer", b.String())
+	}
+}
+
+func TestDownloadPackageFileRange(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		if got := r.Header.Get("Range"); got != "bytes=4-" {
+			t.Errorf("Range header: %s, want bytes=4-", got)
+		}
+		w.Header().Set("Accept-Ranges", "bytes")
+		w.Header().Set("Content-Range", "bytes 4-8/9")
+		w.WriteHeader(http.StatusPartialContent)
+		fmt.Fprint(w, "= baz")
+	})
+
+	var b bytes.Buffer
+	pr, _, err := client.GenericPackages.DownloadPackageFileRange(1234, "foo", "0.1.2", "bar-baz.txt", &b, &DownloadPackageFileRangeOptions{RangeStart: 4})
+	if err != nil {
+		t.Fatalf("GenericPackages.DownloadPackageFileRange returned error: %v", err)
+	}
+
+	want := &PackageFileRange{Partial: true, ContentRange: "bytes 4-8/9", AcceptRanges: "bytes"}
+	if *pr != *want {
+		t.Errorf("GenericPackages.DownloadPackageFileRange returned %+v, want %+v", pr, want)
+	}
+	if got := b.String(); got != "= baz" {
+		t.Errorf("GenericPackages.DownloadPackageFileRange wrote %q, want %q", got, "= baz")
+	}
+}
+
+func TestDownloadPackageFileRangeIgnored(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Range"); got != "bytes=0-2" {
+			t.Errorf("Range header: %s, want bytes=0-2", got)
+		}
+		fmt.Fprint(w, "bar = baz")
+	})
+
+	var b bytes.Buffer
+	end := int64(2)
+	pr, _, err := client.GenericPackages.DownloadPackageFileRange(1234, "foo", "0.1.2", "bar-baz.txt", &b, &DownloadPackageFileRangeOptions{RangeEnd: &end})
+	if err != nil {
+		t.Fatalf("GenericPackages.DownloadPackageFileRange returned error: %v", err)
+	}
+	if pr.Partial {
+		t.Errorf("GenericPackages.DownloadPackageFileRange reported a partial response for status 200")
+	}
+	if got := b.String(); got != "bar = baz" {
+		t.Errorf("GenericPackages.DownloadPackageFileRange wrote %q, want %q", got, "bar = baz")
+	}
+}
+
+func TestPublishPackageFileRetry(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	checksum := "02ca207e44248050552bb6f43f4c09d819b65a3564bb001bab22903e2403cc27"
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		testBody(t, r, "bar = baz")
+		if r.ContentLength != 9 {
+			t.Errorf("Request has content length %d, want %d", r.ContentLength, 9)
+		}
+		fmt.Fprintf(w, `{"id": 1, "package_id": 1000, "file_name": "bar-baz.txt", "file_sha256": %q}`, checksum)
+	})
+
+	opt := &PublishPackageFileOptions{VerifyChecksum: true}
+	_, _, err := client.GenericPackages.PublishPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", strings.NewReader("bar = baz"), opt)
+	if err != nil {
+		t.Fatalf("GenericPackages.PublishPackageFile returned error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("GenericPackages.PublishPackageFile made %d attempts, want %d", attempts, 2)
+	}
+}
+
+func TestPublishPackageFileStreamNotReplayable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, "bar = baz")
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	// A MultiReader hides the Seek method of the strings.Reader.
+	content := io.MultiReader(strings.NewReader("bar = baz"))
+	_, _, err := client.GenericPackages.PublishPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", content, nil)
+	if !errors.Is(err, ErrPackageFileNotReplayable) {
+		t.Errorf("GenericPackages.PublishPackageFile returned error %v, want %v", err, ErrPackageFileNotReplayable)
+	}
+	if attempts != 1 {
+		t.Errorf("GenericPackages.PublishPackageFile made %d attempts, want %d", attempts, 1)
+	}
+}
+
+func TestPublishPackageFileVerifyChecksum(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	checksum := "02ca207e44248050552bb6f43f4c09d819b65a3564bb001bab22903e2403cc27"
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testURL(t, r, "/api/v4/projects/1234/packages/generic/foo/0%2E1%2E2/bar-baz%2Etxt?select=package_file")
+		fmt.Fprintf(w, `{"id": 1, "package_id": 1000, "file_name": "bar-baz.txt", "file_sha256": %q}`, checksum)
+	})
+
+	opt := &PublishPackageFileOptions{VerifyChecksum: true}
+	f, _, err := client.GenericPackages.PublishPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", strings.NewReader("bar = baz"), opt)
+	if err != nil {
+		t.Fatalf("GenericPackages.PublishPackageFile returned error: %v", err)
+	}
+	if f.FileSHA256 != checksum {
+		t.Errorf("GenericPackages.PublishPackageFile returned %+v", f)
+	}
+	if opt.Select != nil {
+		t.Errorf("GenericPackages.PublishPackageFile modified the given options")
+	}
+
+	_, _, err = client.GenericPackages.PublishPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", strings.NewReader("bar = qux"), opt)
+	if err != ErrChecksumMismatch {
+		t.Errorf("GenericPackages.PublishPackageFile returned error %v, want %v", err, ErrChecksumMismatch)
+	}
+}
+
+func TestDownloadPackageFileContextDeadline(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1234/packages/generic/foo/0.1.2/bar-baz.txt", func(w http.ResponseWriter, r *http.Request) {
+		// Send the first part of the body and then stall until the
+		// client goes away.
+		fmt.Fprint(w, "bar")
+		w.(http.Flusher).Flush()
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.GenericPackages.DownloadPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", WithContext(ctx))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GenericPackages.DownloadPackageFile returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("GenericPackages.DownloadPackageFile returned after %v, want it to return promptly", elapsed)
+	}
+}
+
+func TestDownloadPackageFileContextDeadlineBeforeResponse(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	// Stall all requests, including the initial request used to configure
+	// the rate limiter, until the test is done.
+	release := make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/api/v4/", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := client.GenericPackages.DownloadPackageFile(1234, "foo", "0.1.2", "bar-baz.txt", WithContext(ctx))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GenericPackages.DownloadPackageFile returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("GenericPackages.DownloadPackageFile returned after %v, want it to return promptly", elapsed)
+	}
+}
@@ -0,0 +1,324 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ErrGeoNodesForbidden is returned by the GeoNodesService when GitLab rejects
+// a request with 403 Forbidden, either because the user isn't an
+// administrator or because Geo isn't available on the instance's license.
+// The ErrorResponse is wrapped, so use errors.Is to check for it.
+var ErrGeoNodesForbidden = errors.New("Geo nodes API requires administrator access and a Geo license")
+
+// GeoNodesService handles communication with the Geo nodes related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/geo_nodes.html
+type GeoNodesService struct {
+	client *Client
+}
+
+// GeoNode represents a GitLab Geo node.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/geo_nodes.html
+type GeoNode struct {
+	ID                               int          `json:"id"`
+	Name                             string       `json:"name"`
+	URL                              string       `json:"url"`
+	InternalURL                      string       `json:"internal_url"`
+	Primary                          bool         `json:"primary"`
+	Enabled                          bool         `json:"enabled"`
+	Current                          bool         `json:"current"`
+	FilesMaxCapacity                 int          `json:"files_max_capacity"`
+	ReposMaxCapacity                 int          `json:"repos_max_capacity"`
+	VerificationMaxCapacity          int          `json:"verification_max_capacity"`
+	ContainerRepositoriesMaxCapacity int          `json:"container_repositories_max_capacity"`
+	SelectiveSyncType                string       `json:"selective_sync_type"`
+	SelectiveSyncShards              []string     `json:"selective_sync_shards"`
+	SelectiveSyncNamespaceIDs        []int        `json:"selective_sync_namespace_ids"`
+	MinimumReverificationInterval    int          `json:"minimum_reverification_interval"`
+	SyncObjectStorage                bool         `json:"sync_object_storage"`
+	CloneProtocol                    string       `json:"clone_protocol"`
+	WebEditURL                       string       `json:"web_edit_url"`
+	WebGeoProjectsURL                string       `json:"web_geo_projects_url"`
+	Links                            GeoNodeLinks `json:"_links"`
+}
+
+// GeoNodeLinks represents the links of a GitLab Geo node.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/geo_nodes.html
+type GeoNodeLinks struct {
+	Self   string `json:"self"`
+	Status string `json:"status"`
+	Repair string `json:"repair"`
+}
+
+func (n GeoNode) String() string {
+	return Stringify(n)
+}
+
+// GeoNodeStatus represents the replication status of a GitLab Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-a-specific-geo-node
+type GeoNodeStatus struct {
+	GeoNodeID                               int        `json:"geo_node_id"`
+	Healthy                                 bool       `json:"healthy"`
+	Health                                  string     `json:"health"`
+	HealthStatus                            string     `json:"health_status"`
+	MissingOAuthApplication                 bool       `json:"missing_oauth_application"`
+	DBReplicationLagSeconds                 int        `json:"db_replication_lag_seconds"`
+	RepositoriesCount                       int        `json:"repositories_count"`
+	RepositoriesFailedCount                 int        `json:"repositories_failed_count"`
+	RepositoriesSyncedCount                 int        `json:"repositories_synced_count"`
+	RepositoriesSyncedInPercentage          string     `json:"repositories_synced_in_percentage"`
+	WikisCount                              int        `json:"wikis_count"`
+	WikisFailedCount                        int        `json:"wikis_failed_count"`
+	WikisSyncedCount                        int        `json:"wikis_synced_count"`
+	WikisSyncedInPercentage                 string     `json:"wikis_synced_in_percentage"`
+	LFSObjectsCount                         int        `json:"lfs_objects_count"`
+	LFSObjectsFailedCount                   int        `json:"lfs_objects_failed_count"`
+	LFSObjectsSyncedCount                   int        `json:"lfs_objects_synced_count"`
+	LFSObjectsSyncedInPercentage            string     `json:"lfs_objects_synced_in_percentage"`
+	JobArtifactsCount                       int        `json:"job_artifacts_count"`
+	JobArtifactsFailedCount                 int        `json:"job_artifacts_failed_count"`
+	JobArtifactsSyncedCount                 int        `json:"job_artifacts_synced_count"`
+	JobArtifactsSyncedInPercentage          string     `json:"job_artifacts_synced_in_percentage"`
+	ContainerRepositoriesCount              int        `json:"container_repositories_count"`
+	ContainerRepositoriesFailedCount        int        `json:"container_repositories_failed_count"`
+	ContainerRepositoriesSyncedCount        int        `json:"container_repositories_synced_count"`
+	ContainerRepositoriesSyncedInPercentage string     `json:"container_repositories_synced_in_percentage"`
+	ReplicationSlotsCount                   int        `json:"replication_slots_count"`
+	ReplicationSlotsUsedCount               int        `json:"replication_slots_used_count"`
+	ReplicationSlotsMaxRetainedWalBytes     int64      `json:"replication_slots_max_retained_wal_bytes"`
+	LastEventID                             int        `json:"last_event_id"`
+	LastEventTimestamp                      int        `json:"last_event_timestamp"`
+	CursorLastEventID                       int        `json:"cursor_last_event_id"`
+	CursorLastEventTimestamp                int        `json:"cursor_last_event_timestamp"`
+	LastSuccessfulStatusCheckTimestamp      int        `json:"last_successful_status_check_timestamp"`
+	Version                                 string     `json:"version"`
+	Revision                                string     `json:"revision"`
+	StorageShardsMatch                      bool       `json:"storage_shards_match"`
+	UpdatedAt                               *time.Time `json:"updated_at"`
+}
+
+func (s GeoNodeStatus) String() string {
+	return Stringify(s)
+}
+
+// ListGeoNodesOptions represents the available ListGeoNodes() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-configuration-about-all-geo-nodes
+type ListGeoNodesOptions ListOptions
+
+// ListGeoNodes gets a list of all Geo nodes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-configuration-about-all-geo-nodes
+func (s *GeoNodesService) ListGeoNodes(opt *ListGeoNodesOptions, options ...RequestOptionFunc) ([]*GeoNode, *Response, error) {
+	req, err := s.client.NewRequest("GET", "geo_nodes", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ns []*GeoNode
+	resp, err := s.client.Do(req, &ns)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return ns, resp, err
+}
+
+// GetGeoNode gets a specific Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-configuration-about-a-specific-geo-node
+func (s *GeoNodesService) GetGeoNode(id int, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d", id)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(GeoNode)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return n, resp, err
+}
+
+// CreateGeoNodeOptions represents the available CreateGeoNode() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#create-a-new-geo-node
+type CreateGeoNodeOptions struct {
+	Primary                          *bool     `url:"primary,omitempty" json:"primary,omitempty"`
+	Enabled                          *bool     `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Name                             *string   `url:"name,omitempty" json:"name,omitempty"`
+	URL                              *string   `url:"url,omitempty" json:"url,omitempty"`
+	InternalURL                      *string   `url:"internal_url,omitempty" json:"internal_url,omitempty"`
+	FilesMaxCapacity                 *int      `url:"files_max_capacity,omitempty" json:"files_max_capacity,omitempty"`
+	ReposMaxCapacity                 *int      `url:"repos_max_capacity,omitempty" json:"repos_max_capacity,omitempty"`
+	VerificationMaxCapacity          *int      `url:"verification_max_capacity,omitempty" json:"verification_max_capacity,omitempty"`
+	ContainerRepositoriesMaxCapacity *int      `url:"container_repositories_max_capacity,omitempty" json:"container_repositories_max_capacity,omitempty"`
+	SyncObjectStorage                *bool     `url:"sync_object_storage,omitempty" json:"sync_object_storage,omitempty"`
+	SelectiveSyncType                *string   `url:"selective_sync_type,omitempty" json:"selective_sync_type,omitempty"`
+	SelectiveSyncShards              *[]string `url:"selective_sync_shards,omitempty" json:"selective_sync_shards,omitempty"`
+	SelectiveSyncNamespaceIDs        *[]int    `url:"selective_sync_namespace_ids,omitempty" json:"selective_sync_namespace_ids,omitempty"`
+	MinimumReverificationInterval    *int      `url:"minimum_reverification_interval,omitempty" json:"minimum_reverification_interval,omitempty"`
+}
+
+// CreateGeoNode creates a new Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#create-a-new-geo-node
+func (s *GeoNodesService) CreateGeoNode(opt *CreateGeoNodeOptions, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	req, err := s.client.NewRequest("POST", "geo_nodes", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(GeoNode)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return n, resp, err
+}
+
+// EditGeoNodeOptions represents the available EditGeoNode() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#edit-a-geo-node
+type EditGeoNodeOptions struct {
+	Enabled                          *bool     `url:"enabled,omitempty" json:"enabled,omitempty"`
+	Name                             *string   `url:"name,omitempty" json:"name,omitempty"`
+	URL                              *string   `url:"url,omitempty" json:"url,omitempty"`
+	InternalURL                      *string   `url:"internal_url,omitempty" json:"internal_url,omitempty"`
+	FilesMaxCapacity                 *int      `url:"files_max_capacity,omitempty" json:"files_max_capacity,omitempty"`
+	ReposMaxCapacity                 *int      `url:"repos_max_capacity,omitempty" json:"repos_max_capacity,omitempty"`
+	VerificationMaxCapacity          *int      `url:"verification_max_capacity,omitempty" json:"verification_max_capacity,omitempty"`
+	ContainerRepositoriesMaxCapacity *int      `url:"container_repositories_max_capacity,omitempty" json:"container_repositories_max_capacity,omitempty"`
+	SelectiveSyncType                *string   `url:"selective_sync_type,omitempty" json:"selective_sync_type,omitempty"`
+	SelectiveSyncShards              *[]string `url:"selective_sync_shards,omitempty" json:"selective_sync_shards,omitempty"`
+	SelectiveSyncNamespaceIDs        *[]int    `url:"selective_sync_namespace_ids,omitempty" json:"selective_sync_namespace_ids,omitempty"`
+	MinimumReverificationInterval    *int      `url:"minimum_reverification_interval,omitempty" json:"minimum_reverification_interval,omitempty"`
+}
+
+// EditGeoNode updates settings of an existing Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#edit-a-geo-node
+func (s *GeoNodesService) EditGeoNode(id int, opt *EditGeoNodeOptions, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d", id)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(GeoNode)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return n, resp, err
+}
+
+// DeleteGeoNode removes a Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#delete-a-geo-node
+func (s *GeoNodesService) DeleteGeoNode(id int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d", id)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return resp, err
+}
+
+// RepairGeoNode repairs the OAuth authentication of a Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#repair-a-geo-node
+func (s *GeoNodesService) RepairGeoNode(id int, options ...RequestOptionFunc) (*GeoNode, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d/repair", id)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	n := new(GeoNode)
+	resp, err := s.client.Do(req, n)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return n, resp, err
+}
+
+// ListGeoNodesStatusOptions represents the available ListGeoNodesStatus()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-all-geo-nodes
+type ListGeoNodesStatusOptions ListOptions
+
+// ListGeoNodesStatus gets the replication status of all Geo nodes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-all-geo-nodes
+func (s *GeoNodesService) ListGeoNodesStatus(opt *ListGeoNodesStatusOptions, options ...RequestOptionFunc) ([]*GeoNodeStatus, *Response, error) {
+	req, err := s.client.NewRequest("GET", "geo_nodes/status", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ss []*GeoNodeStatus
+	resp, err := s.client.Do(req, &ss)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return ss, resp, err
+}
+
+// GetGeoNodeStatus gets the replication status of a specific Geo node.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/geo_nodes.html#retrieve-status-about-a-specific-geo-node
+func (s *GeoNodesService) GetGeoNodeStatus(id int, options ...RequestOptionFunc) (*GeoNodeStatus, *Response, error) {
+	u := fmt.Sprintf("geo_nodes/%d/status", id)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st := new(GeoNodeStatus)
+	resp, err := s.client.Do(req, st)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrGeoNodesForbidden)
+	}
+
+	return st, resp, err
+}
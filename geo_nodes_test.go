@@ -0,0 +1,188 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListGeoNodes(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"name": "us-node",
+			"url": "https://primary.example.com/",
+			"primary": true,
+			"enabled": true,
+			"current": true,
+			"selective_sync_shards": [],
+			"_links": {
+				"self": "https://primary.example.com/api/v4/geo_nodes/1",
+				"status": "https://primary.example.com/api/v4/geo_nodes/1/status",
+				"repair": "https://primary.example.com/api/v4/geo_nodes/1/repair"
+			}
+		}]`)
+	})
+
+	nodes, _, err := client.GeoNodes.ListGeoNodes(nil)
+	require.NoError(t, err)
+
+	want := []*GeoNode{{
+		ID:                  1,
+		Name:                "us-node",
+		URL:                 "https://primary.example.com/",
+		Primary:             true,
+		Enabled:             true,
+		Current:             true,
+		SelectiveSyncShards: []string{},
+		Links: GeoNodeLinks{
+			Self:   "https://primary.example.com/api/v4/geo_nodes/1",
+			Status: "https://primary.example.com/api/v4/geo_nodes/1/status",
+			Repair: "https://primary.example.com/api/v4/geo_nodes/1/repair",
+		},
+	}}
+	assert.Equal(t, want, nodes)
+}
+
+func TestListGeoNodesForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	_, resp, err := client.GeoNodes.ListGeoNodes(nil)
+	assert.True(t, errors.Is(err, ErrGeoNodesForbidden), "expected ErrGeoNodesForbidden, got %v", err)
+	var errResp *ErrorResponse
+	assert.True(t, errors.As(err, &errResp))
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestCreateGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"eu-node","url":"https://secondary.example.com/","selective_sync_shards":["default"]}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 2, "name": "eu-node", "url": "https://secondary.example.com/", "selective_sync_shards": ["default"]}`)
+	})
+
+	opt := &CreateGeoNodeOptions{
+		Name:                String("eu-node"),
+		URL:                 String("https://secondary.example.com/"),
+		SelectiveSyncShards: &[]string{"default"},
+	}
+	node, _, err := client.GeoNodes.CreateGeoNode(opt)
+	require.NoError(t, err)
+
+	want := &GeoNode{ID: 2, Name: "eu-node", URL: "https://secondary.example.com/", SelectiveSyncShards: []string{"default"}}
+	assert.Equal(t, want, node)
+}
+
+func TestEditGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"enabled":false}`)
+		fmt.Fprint(w, `{"id": 2, "enabled": false}`)
+	})
+
+	node, _, err := client.GeoNodes.EditGeoNode(2, &EditGeoNodeOptions{Enabled: Bool(false)})
+	require.NoError(t, err)
+	assert.Equal(t, &GeoNode{ID: 2}, node)
+}
+
+func TestDeleteGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.GeoNodes.DeleteGeoNode(2)
+	require.NoError(t, err)
+}
+
+func TestRepairGeoNode(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/2/repair", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 2, "name": "eu-node"}`)
+	})
+
+	node, _, err := client.GeoNodes.RepairGeoNode(2)
+	require.NoError(t, err)
+	assert.Equal(t, &GeoNode{ID: 2, Name: "eu-node"}, node)
+}
+
+func TestListGeoNodesStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"geo_node_id": 1, "healthy": true, "health_status": "Healthy"}, {"geo_node_id": 2, "healthy": false, "health_status": "Unhealthy"}]`)
+	})
+
+	statuses, _, err := client.GeoNodes.ListGeoNodesStatus(nil)
+	require.NoError(t, err)
+
+	want := []*GeoNodeStatus{
+		{GeoNodeID: 1, Healthy: true, HealthStatus: "Healthy"},
+		{GeoNodeID: 2, HealthStatus: "Unhealthy"},
+	}
+	assert.Equal(t, want, statuses)
+}
+
+func TestGetGeoNodeStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/geo_nodes/2/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"geo_node_id": 2,
+			"healthy": true,
+			"db_replication_lag_seconds": 3,
+			"repositories_count": 10,
+			"repositories_synced_count": 8,
+			"repositories_failed_count": 2,
+			"repositories_synced_in_percentage": "80.00%",
+			"replication_slots_max_retained_wal_bytes": 4294967296
+		}`)
+	})
+
+	status, _, err := client.GeoNodes.GetGeoNodeStatus(2)
+	require.NoError(t, err)
+
+	want := &GeoNodeStatus{
+		GeoNodeID:                           2,
+		Healthy:                             true,
+		DBReplicationLagSeconds:             3,
+		RepositoriesCount:                   10,
+		RepositoriesSyncedCount:             8,
+		RepositoriesFailedCount:             2,
+		RepositoriesSyncedInPercentage:      "80.00%",
+		ReplicationSlotsMaxRetainedWalBytes: 4294967296,
+	}
+	assert.Equal(t, want, status)
+}
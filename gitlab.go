@@ -44,8 +44,14 @@ const (
 	apiVersionPath = "api/v4/"
 	userAgent      = "go-gitlab"
 
-	headerRateLimit = "RateLimit-Limit"
-	headerRateReset = "RateLimit-Reset"
+	headerRateLimit     = "RateLimit-Limit"
+	headerRateRemaining = "RateLimit-Remaining"
+	headerRateReset     = "RateLimit-Reset"
+	headerRetryAfter    = "Retry-After"
+
+	// limiterProbeTimeout is the maximum time spent on the request used to
+	// configure the rate limiter.
+	limiterProbeTimeout = 10 * time.Second
 )
 
 // authType represents an authentication type within GitLab.
@@ -75,10 +81,19 @@ type Client struct {
 	// disableRetries is used to disable the default retry logic.
 	disableRetries bool
 
+	// tokenSource is used to get the OAuth token for every request, if set.
+	tokenSource oauth2.TokenSource
+
+	// requestLogger is called after every attempt of an API request.
+	requestLogger RequestLogger
+
 	// configureLimiterOnce is used to make sure the limiter is configured exactly
 	// once and block all other calls until the initial (one) call is done.
 	configureLimiterOnce sync.Once
 
+	// limiterConfigured is closed once the limiter is configured.
+	limiterConfigured chan struct{}
+
 	// Limiter is used to limit API calls and prevent 429 responses.
 	limiter *rate.Limiter
 
@@ -98,79 +113,105 @@ type Client struct {
 	UserAgent string
 
 	// Services used for talking to different parts of the GitLab API.
-	AccessRequests        *AccessRequestsService
-	Applications          *ApplicationsService
-	AwardEmoji            *AwardEmojiService
-	Boards                *IssueBoardsService
-	Branches              *BranchesService
-	BroadcastMessage      *BroadcastMessagesService
-	CIYMLTemplate         *CIYMLTemplatesService
-	Commits               *CommitsService
-	ContainerRegistry     *ContainerRegistryService
-	CustomAttribute       *CustomAttributesService
-	DeployKeys            *DeployKeysService
-	DeployTokens          *DeployTokensService
-	Deployments           *DeploymentsService
-	Discussions           *DiscussionsService
-	Environments          *EnvironmentsService
-	EpicIssues            *EpicIssuesService
-	Epics                 *EpicsService
-	Events                *EventsService
-	Features              *FeaturesService
-	GitIgnoreTemplates    *GitIgnoreTemplatesService
-	GroupBadges           *GroupBadgesService
-	GroupCluster          *GroupClustersService
-	GroupIssueBoards      *GroupIssueBoardsService
-	GroupLabels           *GroupLabelsService
-	GroupMembers          *GroupMembersService
-	GroupMilestones       *GroupMilestonesService
-	GroupVariables        *GroupVariablesService
-	Groups                *GroupsService
-	IssueLinks            *IssueLinksService
-	Issues                *IssuesService
-	Jobs                  *JobsService
-	Keys                  *KeysService
-	Labels                *LabelsService
-	License               *LicenseService
-	LicenseTemplates      *LicenseTemplatesService
-	MergeRequestApprovals *MergeRequestApprovalsService
-	MergeRequests         *MergeRequestsService
-	Milestones            *MilestonesService
-	Namespaces            *NamespacesService
-	Notes                 *NotesService
-	NotificationSettings  *NotificationSettingsService
-	PagesDomains          *PagesDomainsService
-	PipelineSchedules     *PipelineSchedulesService
-	PipelineTriggers      *PipelineTriggersService
-	Pipelines             *PipelinesService
-	ProjectBadges         *ProjectBadgesService
-	ProjectCluster        *ProjectClustersService
-	ProjectImportExport   *ProjectImportExportService
-	ProjectMembers        *ProjectMembersService
-	ProjectMirrors        *ProjectMirrorService
-	ProjectSnippets       *ProjectSnippetsService
-	ProjectVariables      *ProjectVariablesService
-	Projects              *ProjectsService
-	ProtectedBranches     *ProtectedBranchesService
-	ProtectedTags         *ProtectedTagsService
-	ReleaseLinks          *ReleaseLinksService
-	Releases              *ReleasesService
-	Repositories          *RepositoriesService
-	RepositoryFiles       *RepositoryFilesService
-	ResourceLabelEvents   *ResourceLabelEventsService
-	Runners               *RunnersService
-	Search                *SearchService
-	Services              *ServicesService
-	Settings              *SettingsService
-	Sidekiq               *SidekiqService
-	Snippets              *SnippetsService
-	SystemHooks           *SystemHooksService
-	Tags                  *TagsService
-	Todos                 *TodosService
-	Users                 *UsersService
-	Validate              *ValidateService
-	Version               *VersionService
-	Wikis                 *WikisService
+	AccessRequests          *AccessRequestsService
+	Applications            *ApplicationsService
+	AuditEvents             *AuditEventsService
+	AwardEmoji              *AwardEmojiService
+	Boards                  *IssueBoardsService
+	Branches                *BranchesService
+	BroadcastMessage        *BroadcastMessagesService
+	CIYMLTemplate           *CIYMLTemplatesService
+	ClusterAgents           *ClusterAgentsService
+	Commits                 *CommitsService
+	ContainerRegistry       *ContainerRegistryService
+	CustomAttribute         *CustomAttributesService
+	DORAMetrics             *DORAMetricsService
+	DependencyProxy         *DependencyProxyService
+	DeployKeys              *DeployKeysService
+	DeployTokens            *DeployTokensService
+	Deployments             *DeploymentsService
+	Discussions             *DiscussionsService
+	Environments            *EnvironmentsService
+	EpicIssues              *EpicIssuesService
+	Epics                   *EpicsService
+	ErrorTracking           *ErrorTrackingService
+	Events                  *EventsService
+	FeatureFlags            *FeatureFlagsService
+	Features                *FeaturesService
+	FreezePeriods           *FreezePeriodsService
+	GenericPackages         *GenericPackagesService
+	GeoNodes                *GeoNodesService
+	GitIgnoreTemplates      *GitIgnoreTemplatesService
+	GroupAccessTokens       *GroupAccessTokensService
+	GroupBadges             *GroupBadgesService
+	GroupCluster            *GroupClustersService
+	GroupIssueBoards        *GroupIssueBoardsService
+	GroupLabels             *GroupLabelsService
+	GroupMembers            *GroupMembersService
+	GroupMilestones         *GroupMilestonesService
+	GroupVariables          *GroupVariablesService
+	Groups                  *GroupsService
+	InstanceVariables       *InstanceVariablesService
+	IssueLinks              *IssueLinksService
+	Issues                  *IssuesService
+	Iterations              *IterationsService
+	Jobs                    *JobsService
+	Keys                    *KeysService
+	Labels                  *LabelsService
+	License                 *LicenseService
+	LicenseTemplates        *LicenseTemplatesService
+	Markdown                *MarkdownService
+	MemberRoles             *MemberRolesService
+	MergeRequestApprovals   *MergeRequestApprovalsService
+	MergeRequests           *MergeRequestsService
+	MergeTrains             *MergeTrainsService
+	Milestones              *MilestonesService
+	NPMPackages             *NPMPackagesService
+	Namespaces              *NamespacesService
+	Notes                   *NotesService
+	NotificationSettings    *NotificationSettingsService
+	Packages                *PackagesService
+	PagesDomains            *PagesDomainsService
+	PersonalAccessTokens    *PersonalAccessTokensService
+	PipelineSchedules       *PipelineSchedulesService
+	PipelineTriggers        *PipelineTriggersService
+	Pipelines               *PipelinesService
+	ProjectAccessTokens     *ProjectAccessTokensService
+	ProjectBadges           *ProjectBadgesService
+	ProjectCluster          *ProjectClustersService
+	ProjectImportExport     *ProjectImportExportService
+	ProjectMembers          *ProjectMembersService
+	ProjectMirrors          *ProjectMirrorService
+	ProjectSnippets         *ProjectSnippetsService
+	ProjectStorageMoves     *ProjectStorageMovesService
+	ProjectVariables        *ProjectVariablesService
+	Projects                *ProjectsService
+	ProtectedBranches       *ProtectedBranchesService
+	ProtectedEnvironments   *ProtectedEnvironmentsService
+	ProtectedTags           *ProtectedTagsService
+	PyPIPackages            *PyPIPackagesService
+	ReleaseLinks            *ReleaseLinksService
+	Releases                *ReleasesService
+	Repositories            *RepositoriesService
+	RepositoryFiles         *RepositoryFilesService
+	RepositorySubmodules    *RepositorySubmodulesService
+	ResourceIterationEvents *ResourceIterationEventsService
+	ResourceLabelEvents     *ResourceLabelEventsService
+	ResourceStateEvents     *ResourceStateEventsService
+	ResourceWeightEvents    *ResourceWeightEventsService
+	Runners                 *RunnersService
+	Search                  *SearchService
+	Services                *ServicesService
+	Settings                *SettingsService
+	Sidekiq                 *SidekiqService
+	Snippets                *SnippetsService
+	SystemHooks             *SystemHooksService
+	Tags                    *TagsService
+	Todos                   *TodosService
+	Users                   *UsersService
+	Validate                *ValidateService
+	Version                 *VersionService
+	Wikis                   *WikisService
 }
 
 // ListOptions specifies the optional parameters to various List methods that
@@ -223,7 +264,7 @@ func NewOAuthClient(token string, options ...ClientOptionFunc) (*Client, error)
 }
 
 func newClient(options ...ClientOptionFunc) (*Client, error) {
-	c := &Client{UserAgent: userAgent}
+	c := &Client{UserAgent: userAgent, limiterConfigured: make(chan struct{})}
 
 	// Configure the HTTP client.
 	c.client = &retryablehttp.Client{
@@ -235,6 +276,7 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 		RetryWaitMax: 400 * time.Millisecond,
 		RetryMax:     5,
 	}
+	c.client.HTTPClient.CheckRedirect = checkRedirect
 
 	// Set the default base URL.
 	c.setBaseURL(defaultBaseURL)
@@ -249,20 +291,31 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 		}
 	}
 
+	// Install the hooks needed to log each request attempt. This is done
+	// after applying the options, so any custom retry policy is wrapped.
+	if c.requestLogger != nil {
+		c.client.RequestLogHook = c.requestLogHook
+		c.client.CheckRetry = c.logRetryCheck(c.client.CheckRetry)
+	}
+
 	// Create the internal timeStats service.
 	timeStats := &timeStatsService{client: c}
 
 	// Create all the public services.
 	c.AccessRequests = &AccessRequestsService{client: c}
 	c.Applications = &ApplicationsService{client: c}
+	c.AuditEvents = &AuditEventsService{client: c}
 	c.AwardEmoji = &AwardEmojiService{client: c}
 	c.Boards = &IssueBoardsService{client: c}
 	c.Branches = &BranchesService{client: c}
 	c.BroadcastMessage = &BroadcastMessagesService{client: c}
 	c.CIYMLTemplate = &CIYMLTemplatesService{client: c}
+	c.ClusterAgents = &ClusterAgentsService{client: c}
 	c.Commits = &CommitsService{client: c}
 	c.ContainerRegistry = &ContainerRegistryService{client: c}
 	c.CustomAttribute = &CustomAttributesService{client: c}
+	c.DORAMetrics = &DORAMetricsService{client: c}
+	c.DependencyProxy = &DependencyProxyService{client: c}
 	c.DeployKeys = &DeployKeysService{client: c}
 	c.DeployTokens = &DeployTokensService{client: c}
 	c.Deployments = &DeploymentsService{client: c}
@@ -270,9 +323,15 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.Environments = &EnvironmentsService{client: c}
 	c.EpicIssues = &EpicIssuesService{client: c}
 	c.Epics = &EpicsService{client: c}
+	c.ErrorTracking = &ErrorTrackingService{client: c}
 	c.Events = &EventsService{client: c}
+	c.FeatureFlags = &FeatureFlagsService{client: c}
 	c.Features = &FeaturesService{client: c}
+	c.FreezePeriods = &FreezePeriodsService{client: c}
+	c.GenericPackages = &GenericPackagesService{client: c}
+	c.GeoNodes = &GeoNodesService{client: c}
 	c.GitIgnoreTemplates = &GitIgnoreTemplatesService{client: c}
+	c.GroupAccessTokens = &GroupAccessTokensService{client: c}
 	c.GroupBadges = &GroupBadgesService{client: c}
 	c.GroupCluster = &GroupClustersService{client: c}
 	c.GroupIssueBoards = &GroupIssueBoardsService{client: c}
@@ -281,38 +340,54 @@ func newClient(options ...ClientOptionFunc) (*Client, error) {
 	c.GroupMilestones = &GroupMilestonesService{client: c}
 	c.GroupVariables = &GroupVariablesService{client: c}
 	c.Groups = &GroupsService{client: c}
+	c.InstanceVariables = &InstanceVariablesService{client: c}
 	c.IssueLinks = &IssueLinksService{client: c}
 	c.Issues = &IssuesService{client: c, timeStats: timeStats}
+	c.Iterations = &IterationsService{client: c}
 	c.Jobs = &JobsService{client: c}
 	c.Keys = &KeysService{client: c}
 	c.Labels = &LabelsService{client: c}
 	c.License = &LicenseService{client: c}
 	c.LicenseTemplates = &LicenseTemplatesService{client: c}
+	c.Markdown = &MarkdownService{client: c}
+	c.MemberRoles = &MemberRolesService{client: c}
 	c.MergeRequestApprovals = &MergeRequestApprovalsService{client: c}
 	c.MergeRequests = &MergeRequestsService{client: c, timeStats: timeStats}
+	c.MergeTrains = &MergeTrainsService{client: c}
 	c.Milestones = &MilestonesService{client: c}
+	c.NPMPackages = &NPMPackagesService{client: c}
 	c.Namespaces = &NamespacesService{client: c}
 	c.Notes = &NotesService{client: c}
 	c.NotificationSettings = &NotificationSettingsService{client: c}
+	c.Packages = &PackagesService{client: c}
 	c.PagesDomains = &PagesDomainsService{client: c}
+	c.PersonalAccessTokens = &PersonalAccessTokensService{client: c}
 	c.PipelineSchedules = &PipelineSchedulesService{client: c}
 	c.PipelineTriggers = &PipelineTriggersService{client: c}
 	c.Pipelines = &PipelinesService{client: c}
+	c.ProjectAccessTokens = &ProjectAccessTokensService{client: c}
 	c.ProjectBadges = &ProjectBadgesService{client: c}
 	c.ProjectCluster = &ProjectClustersService{client: c}
 	c.ProjectImportExport = &ProjectImportExportService{client: c}
 	c.ProjectMembers = &ProjectMembersService{client: c}
 	c.ProjectMirrors = &ProjectMirrorService{client: c}
 	c.ProjectSnippets = &ProjectSnippetsService{client: c}
+	c.ProjectStorageMoves = &ProjectStorageMovesService{client: c}
 	c.ProjectVariables = &ProjectVariablesService{client: c}
 	c.Projects = &ProjectsService{client: c}
 	c.ProtectedBranches = &ProtectedBranchesService{client: c}
+	c.ProtectedEnvironments = &ProtectedEnvironmentsService{client: c}
 	c.ProtectedTags = &ProtectedTagsService{client: c}
+	c.PyPIPackages = &PyPIPackagesService{client: c}
 	c.ReleaseLinks = &ReleaseLinksService{client: c}
 	c.Releases = &ReleasesService{client: c}
 	c.Repositories = &RepositoriesService{client: c}
 	c.RepositoryFiles = &RepositoryFilesService{client: c}
+	c.RepositorySubmodules = &RepositorySubmodulesService{client: c}
+	c.ResourceIterationEvents = &ResourceIterationEventsService{client: c}
 	c.ResourceLabelEvents = &ResourceLabelEventsService{client: c}
+	c.ResourceStateEvents = &ResourceStateEventsService{client: c}
+	c.ResourceWeightEvents = &ResourceWeightEventsService{client: c}
 	c.Runners = &RunnersService{client: c}
 	c.Search = &SearchService{client: c}
 	c.Services = &ServicesService{client: c}
@@ -345,6 +420,115 @@ func (c *Client) retryHTTPCheck(ctx context.Context, resp *http.Response, err er
 	return false, nil
 }
 
+// retryPolicyCheck provides a callback for Client.CheckRetry which is used
+// when a retry policy is configured using WithRetry. It will retry both rate
+// limit (429) and server (>= 500) errors, but only for safe methods or for
+// requests that are explicitly marked as retryable.
+func (c *Client) retryPolicyCheck(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+	if err != nil {
+		return false, err
+	}
+	if c.disableRetries || (resp.StatusCode != 429 && resp.StatusCode < 500) {
+		return false, nil
+	}
+
+	switch resp.Request.Method {
+	case "GET", "HEAD":
+		return true, nil
+	}
+
+	retryable, _ := ctx.Value(retryableKey{}).(bool)
+	return retryable, nil
+}
+
+// RequestLogger is called after every attempt of an API request, including
+// attempts that failed or returned an error response.
+type RequestLogger func(entry RequestLogEntry)
+
+// RequestLogEntry describes a single attempt of an API request. To make sure
+// no credentials are leaked, it never contains the headers or the body of
+// the request.
+type RequestLogEntry struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Duration   time.Duration
+	Attempt    int
+	Err        error
+}
+
+// requestTraceKey is the context key used to store the trace of a request.
+type requestTraceKey struct{}
+
+// requestTrace keeps track of the current attempt of a request.
+type requestTrace struct {
+	method  string
+	url     string
+	attempt int
+	start   time.Time
+}
+
+// requestLogHook provides a callback for Client.RequestLogHook which records
+// the start of every attempt of a request.
+func (c *Client) requestLogHook(_ retryablehttp.Logger, req *http.Request, attempt int) {
+	if t, ok := req.Context().Value(requestTraceKey{}).(*requestTrace); ok {
+		t.method = req.Method
+		t.url = req.URL.Redacted()
+		t.attempt = attempt + 1
+		t.start = time.Now()
+	}
+}
+
+// logRetryCheck wraps a Client.CheckRetry callback, so the request logger is
+// called after every attempt. The retry check is used for this, because it is
+// the only callback that is also called when an attempt failed completely.
+func (c *Client) logRetryCheck(checkRetry retryablehttp.CheckRetry) retryablehttp.CheckRetry {
+	return func(ctx context.Context, resp *http.Response, err error) (bool, error) {
+		if t, ok := ctx.Value(requestTraceKey{}).(*requestTrace); ok {
+			entry := RequestLogEntry{
+				Method:   t.method,
+				URL:      t.url,
+				Duration: time.Since(t.start),
+				Attempt:  t.attempt,
+				Err:      err,
+			}
+			if resp != nil {
+				entry.StatusCode = resp.StatusCode
+			}
+			c.requestLogger(entry)
+		}
+		return checkRetry(ctx, resp, err)
+	}
+}
+
+// retryAfter returns the time to wait as indicated by the Retry-After header
+// of the response, if present.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	v := resp.Header.Get(headerRetryAfter)
+	if v == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if wait := time.Until(t); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
 // retryHTTPBackoff provides a generic callback for Client.Backoff which
 // will pass through all calls based on the status code of the response.
 func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
@@ -389,7 +573,7 @@ func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Respons
 }
 
 // configureLimiter configures the rate limiter.
-func (c *Client) configureLimiter() error {
+func (c *Client) configureLimiter(ctx context.Context) error {
 	// Set default values for when rate limiting is disabled.
 	limit := rate.Inf
 	burst := 0
@@ -400,7 +584,7 @@ func (c *Client) configureLimiter() error {
 	}()
 
 	// Create a new request.
-	req, err := http.NewRequest("GET", c.baseURL.String(), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL.String(), nil)
 	if err != nil {
 		return err
 	}
@@ -438,6 +622,20 @@ func (c *Client) BaseURL() *url.URL {
 
 // setBaseURL sets the base URL for API requests to a custom endpoint.
 func (c *Client) setBaseURL(urlStr string) error {
+	baseURL, err := parseBaseURL(urlStr)
+	if err != nil {
+		return err
+	}
+
+	// Update the base URL of the client.
+	c.baseURL = baseURL
+
+	return nil
+}
+
+// parseBaseURL parses the given URL and makes sure it ends with the API
+// version path.
+func parseBaseURL(urlStr string) (*url.URL, error) {
 	// Make sure the given URL end with a slash
 	if !strings.HasSuffix(urlStr, "/") {
 		urlStr += "/"
@@ -445,17 +643,14 @@ func (c *Client) setBaseURL(urlStr string) error {
 
 	baseURL, err := url.Parse(urlStr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !strings.HasSuffix(baseURL.Path, apiVersionPath) {
 		baseURL.Path += apiVersionPath
 	}
 
-	// Update the base URL of the client.
-	c.baseURL = baseURL
-
-	return nil
+	return baseURL, nil
 }
 
 // NewRequest creates an API request. A relative URL path can be provided in
@@ -484,7 +679,7 @@ func (c *Client) NewRequest(method, path string, opt interface{}, options []Requ
 
 	var body interface{}
 	switch {
-	case method == "POST" || method == "PUT":
+	case method == "POST" || method == "PUT" || method == "PATCH":
 		reqHeaders.Set("Content-Type", "application/json")
 
 		if opt != nil {
@@ -539,12 +734,17 @@ type Response struct {
 	CurrentPage  int
 	NextPage     int
 	PreviousPage int
+
+	// This field is populated when using keyset pagination and contains the
+	// link to the next page of results. It is empty on the last page.
+	NextLink string
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.populatePageValues()
+	response.populateLinkValues()
 	return response
 }
 
@@ -555,6 +755,9 @@ const (
 	xPage       = "X-Page"
 	xNextPage   = "X-Next-Page"
 	xPrevPage   = "X-Prev-Page"
+
+	// Headers used for keyset pagination.
+	linkNext = "next"
 )
 
 // populatePageValues parses the HTTP Link response headers and populates the
@@ -580,15 +783,60 @@ func (r *Response) populatePageValues() {
 	}
 }
 
+// populateLinkValues parses the HTTP Link response header and populates the
+// keyset pagination link values in the Response.
+func (r *Response) populateLinkValues() {
+	for _, link := range strings.Split(r.Response.Header.Get("Link"), ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+
+		linkValue := strings.Trim(strings.TrimSpace(parts[0]), "<>")
+
+		for _, param := range parts[1:] {
+			kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+			if len(kv) != 2 || kv[0] != "rel" {
+				continue
+			}
+
+			switch strings.Trim(kv[1], "\"") {
+			case linkNext:
+				r.NextLink = linkValue
+			}
+		}
+	}
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
 // first decode it.
 func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error) {
-	// If not yet configured, try to configure the rate limiter. Fail
-	// silently as the limiter will be disabled in case of an error.
-	c.configureLimiterOnce.Do(func() { c.configureLimiter() })
+	// If not yet configured, try to configure the rate limiter. This is done
+	// in the background with a context detached from the request, so a short
+	// deadline or a cancelled request doesn't disable the limiter for the
+	// lifetime of the client. Fail silently as the limiter will be disabled
+	// in case of an error.
+	c.configureLimiterOnce.Do(func() {
+		go func() {
+			defer close(c.limiterConfigured)
+
+			ctx, cancel := context.WithTimeout(context.Background(), limiterProbeTimeout)
+			defer cancel()
+
+			c.configureLimiter(ctx)
+		}()
+	})
+
+	// Wait until the limiter is configured, but not beyond the deadline of
+	// the request.
+	select {
+	case <-c.limiterConfigured:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
 
 	// Wait will block until the limiter can obtain a new token.
 	err := c.limiter.Wait(req.Context())
@@ -596,11 +844,20 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		return nil, err
 	}
 
-	// Set the correct authentication header. If using basic auth, then check
-	// if we already have a token and if not first authenticate and get one.
+	// Set the correct authentication header. If using a token source, then
+	// get a (possibly refreshed) token from it for every request. If using
+	// basic auth, then check if we already have a token and if not first
+	// authenticate and get one.
 	var basicAuthToken string
-	switch c.authType {
-	case basicAuth:
+	var sourceToken *oauth2.Token
+	switch {
+	case c.tokenSource != nil:
+		sourceToken, err = c.tokenSource.Token()
+		if err != nil {
+			return nil, err
+		}
+		sourceToken.SetAuthHeader(req.Request)
+	case c.authType == basicAuth:
 		c.tokenLock.RLock()
 		basicAuthToken = c.token
 		c.tokenLock.RUnlock()
@@ -612,16 +869,30 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 			}
 		}
 		req.Header.Set("Authorization", "Bearer "+basicAuthToken)
-	case oAuthToken:
+	case c.authType == oAuthToken:
 		req.Header.Set("Authorization", "Bearer "+c.token)
-	case privateToken:
+	case c.authType == privateToken:
 		req.Header.Set("PRIVATE-TOKEN", c.token)
 	}
 
+	// Keep track of the attempts of this request, so they can be logged.
+	if c.requestLogger != nil {
+		req = req.WithContext(context.WithValue(req.Context(), requestTraceKey{}, new(requestTrace)))
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
+
+	if resp.StatusCode == http.StatusUnauthorized && c.tokenSource != nil {
+		// The token was most likely revoked or expired early, so try once
+		// more if the token source is able to provide a new token.
+		resp, err = c.retryWithNewToken(req, resp, sourceToken)
+		if err != nil {
+			return nil, err
+		}
+	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusUnauthorized && c.authType == basicAuth {
@@ -641,10 +912,15 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 		return response, err
 	}
 
-	if v != nil {
-		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
-		} else {
+	// A 304 Not Modified response never has a body, so there is nothing
+	// to decode.
+	if v != nil && resp.StatusCode != http.StatusNotModified {
+		switch v := v.(type) {
+		case responseHandler:
+			err = v(resp)
+		case io.Writer:
+			_, err = io.Copy(v, resp.Body)
+		default:
 			err = json.NewDecoder(resp.Body).Decode(v)
 		}
 	}
@@ -652,6 +928,47 @@ func (c *Client) Do(req *retryablehttp.Request, v interface{}) (*Response, error
 	return response, err
 }
 
+// A responseHandler can be passed to Do to read the body of a successful
+// response itself, for example when the way to read it depends on the status
+// code of the response.
+type responseHandler func(resp *http.Response) error
+
+// checkRedirect makes sure the private token isn't leaked when GitLab
+// redirects to another host, for example to the object storage serving job
+// artifacts or packages. Other credentials are already removed by the HTTP
+// client itself in that case.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return fmt.Errorf("stopped after 10 redirects")
+	}
+	if req.URL.Host != via[0].URL.Host {
+		req.Header.Del("PRIVATE-TOKEN")
+	}
+	return nil
+}
+
+// retryWithNewToken retries a request that was rejected with the given token,
+// but only when the token source returns a different token. Otherwise the
+// original response is returned.
+func (c *Client) retryWithNewToken(req *retryablehttp.Request, resp *http.Response, token *oauth2.Token) (*http.Response, error) {
+	t, err := c.tokenSource.Token()
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	if t.AccessToken == token.AccessToken {
+		return resp, nil
+	}
+
+	// Drain and close the original response, before sending the request again.
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+
+	t.SetAuthHeader(req.Request)
+
+	return c.client.Do(req)
+}
+
 func (c *Client) requestOAuthToken(ctx context.Context, token string) (string, error) {
 	c.tokenLock.Lock()
 	defer c.tokenLock.Unlock()
@@ -712,10 +1029,115 @@ func (e *ErrorResponse) Error() string {
 	return fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, u, e.Response.StatusCode, e.Message)
 }
 
+// wrapErrorResponse wraps err with sentinel when err is an ErrorResponse
+// with the given status code and a message containing msg. An empty msg
+// matches any message. The returned error matches sentinel when using
+// errors.Is, while the original ErrorResponse stays available with errors.As.
+// Any other error is returned unchanged.
+func wrapErrorResponse(err error, status int, msg string, sentinel error) error {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || errResp.Response.StatusCode != status || !strings.Contains(errResp.Message, msg) {
+		return err
+	}
+	return &sentinelError{sentinel: sentinel, err: errResp}
+}
+
+// sentinelError is an ErrorResponse that was identified as one of the
+// error variables of this package.
+type sentinelError struct {
+	sentinel error
+	err      *ErrorResponse
+}
+
+func (e *sentinelError) Error() string {
+	return fmt.Sprintf("%s: %s", e.sentinel, e.err.Message)
+}
+
+func (e *sentinelError) Is(target error) bool {
+	return target == e.sentinel
+}
+
+func (e *sentinelError) Unwrap() error {
+	return e.err
+}
+
+// probeNotFound is used when GitLab returns a 404 both when the requested
+// resource doesn't exist and when its parent resource doesn't exist. It calls
+// probe to request the parent. If the parent exists, err is wrapped with
+// notFound. If the parent doesn't exist either, err is wrapped with
+// parentNotFound. A nil sentinel or a failing probe leaves err unchanged, as
+// does any error that isn't a 404 ErrorResponse.
+func probeNotFound(err error, probe func() (*Response, error), notFound, parentNotFound error) error {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || errResp.Response.StatusCode != http.StatusNotFound {
+		return err
+	}
+
+	resp, probeErr := probe()
+	switch {
+	case probeErr == nil && notFound != nil:
+		return &sentinelError{sentinel: notFound, err: errResp}
+	case probeErr != nil && parentNotFound != nil && resp != nil && resp.StatusCode == http.StatusNotFound:
+		return &sentinelError{sentinel: parentNotFound, err: errResp}
+	}
+	return err
+}
+
+// A RateLimitError is returned when a request is rejected because the rate
+// limit was exceeded (429 Too Many Requests). It embeds the ErrorResponse, so
+// it can also be handled as any other error response.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/user/admin_area/settings/user_and_ip_rate_limits.html
+type RateLimitError struct {
+	*ErrorResponse
+
+	// Reset is the time at which the rate limit resets.
+	Reset time.Time
+
+	// Remaining is the number of requests remaining in the current window.
+	Remaining int
+
+	// RetryAfter is the duration to wait before retrying the request.
+	RetryAfter time.Duration
+}
+
+// Unwrap returns the embedded ErrorResponse.
+func (e *RateLimitError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// newRateLimitError parses the rate limit headers of r into a RateLimitError.
+func newRateLimitError(r *http.Response, errorResponse *ErrorResponse) *RateLimitError {
+	e := &RateLimitError{ErrorResponse: errorResponse}
+
+	if v := r.Header.Get(headerRateReset); v != "" {
+		if reset, _ := strconv.ParseInt(v, 10, 64); reset > 0 {
+			e.Reset = time.Unix(reset, 0)
+		}
+	}
+	if v := r.Header.Get(headerRateRemaining); v != "" {
+		e.Remaining, _ = strconv.Atoi(v)
+	}
+
+	e.RetryAfter, _ = retryAfter(r)
+
+	// Fall back to the reset time when no Retry-After header is present.
+	if e.RetryAfter <= 0 && !e.Reset.IsZero() {
+		e.RetryAfter = time.Until(e.Reset)
+	}
+	if e.RetryAfter < 0 {
+		e.RetryAfter = 0
+	}
+
+	return e
+}
+
 // CheckResponse checks the API response for errors, and returns them if present.
+// A 429 response is returned as a *RateLimitError.
 func CheckResponse(r *http.Response) error {
 	switch r.StatusCode {
-	case 200, 201, 202, 204, 304:
+	case 200, 201, 202, 204, 206, 304:
 		return nil
 	}
 
@@ -732,6 +1154,10 @@ func CheckResponse(r *http.Response) error {
 		}
 	}
 
+	if r.StatusCode == http.StatusTooManyRequests {
+		return newRateLimitError(r, errorResponse)
+	}
+
 	return errorResponse
 }
 
@@ -5,16 +5,22 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
 )
 
 // setup sets up a test HTTP server along with a gitlab.Client that is
@@ -212,3 +218,423 @@ func loadFixture(filePath string) []byte {
 
 	return content
 }
+
+func TestCheckResponseRateLimit(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	req, err := c.NewRequest("GET", "test", nil, nil)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+
+	reset := time.Now().Add(time.Minute).Truncate(time.Second)
+	resp := &http.Response{
+		Request:    req.Request,
+		StatusCode: http.StatusTooManyRequests,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(strings.NewReader(`{"message": "Retry later"}`)),
+	}
+	resp.Header.Set(headerRateRemaining, "0")
+	resp.Header.Set(headerRateReset, strconv.FormatInt(reset.Unix(), 10))
+	resp.Header.Set(headerRetryAfter, "30")
+
+	err = CheckResponse(resp)
+
+	var rle *RateLimitError
+	if !errors.As(err, &rle) {
+		t.Fatalf("Expected *RateLimitError, got %T", err)
+	}
+	if !rle.Reset.Equal(reset) {
+		t.Errorf("Expected Reset %v, got %v", reset, rle.Reset)
+	}
+	if rle.Remaining != 0 {
+		t.Errorf("Expected Remaining 0, got %d", rle.Remaining)
+	}
+	if rle.RetryAfter != 30*time.Second {
+		t.Errorf("Expected RetryAfter 30s, got %v", rle.RetryAfter)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Expected error to unwrap to *ErrorResponse")
+	}
+	if errResp.Message != "{message: Retry later}" {
+		t.Errorf("Unexpected error message: %s", errResp.Message)
+	}
+
+	// Without a Retry-After header the reset time is used.
+	resp.Header.Del(headerRetryAfter)
+	resp.Body = ioutil.NopCloser(strings.NewReader(`{}`))
+
+	if !errors.As(CheckResponse(resp), &rle) {
+		t.Fatalf("Expected *RateLimitError")
+	}
+	if rle.RetryAfter <= 0 || rle.RetryAfter > time.Minute {
+		t.Errorf("Expected RetryAfter up to a minute, got %v", rle.RetryAfter)
+	}
+}
+
+func TestWithRetry(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	if err := WithRetry(2, func(int) time.Duration { return time.Millisecond })(client); err != nil {
+		t.Fatalf("Failed to configure retry policy: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		attempts++
+		if attempts == 1 {
+			w.Header().Set(headerRetryAfter, "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	project, _, err := client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if project.ID != 1 {
+		t.Errorf("Projects.GetProject returned %+v", project)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWithRetryUnsafeMethod(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	if err := WithRetry(2, func(int) time.Duration { return time.Millisecond })(client); err != nil {
+		t.Fatalf("Failed to configure retry policy: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"foo"}`)
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	opt := &CreateProjectOptions{Name: String("foo")}
+
+	_, resp, err := client.Projects.CreateProject(opt)
+	if err == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected a 503 error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+
+	attempts = 0
+	project, _, err := client.Projects.CreateProject(opt, WithRetryable())
+	if err != nil {
+		t.Fatalf("Projects.CreateProject returned error: %v", err)
+	}
+	if project.ID != 1 {
+		t.Errorf("Projects.CreateProject returned %+v", project)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWithRetryContextCanceled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	if err := WithRetry(5, func(int) time.Duration { return time.Hour })(client); err != nil {
+		t.Fatalf("Failed to configure retry policy: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		cancel()
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, _, err := client.Projects.GetProject(1, nil, WithContext(ctx))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestConfigureLimiterDetachedFromRequest(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v4/" {
+			// Respond to the request used to configure the rate limiter
+			// after the deadline of the first request has passed.
+			time.Sleep(200 * time.Millisecond)
+			w.Header().Set(headerRateLimit, "600")
+		}
+		fmt.Fprint(w, `{"id":1}`)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Projects.GetProject(1, nil, WithContext(ctx))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded, got %v", err)
+	}
+
+	_, _, err = client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+
+	if client.limiter.Limit() == rate.Inf {
+		t.Errorf("Expected the rate limiter to be configured, got an unlimited rate")
+	}
+}
+
+func TestWithRequestLogger(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var entries []RequestLogEntry
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithRetry(2, func(int) time.Duration { return time.Millisecond }),
+		WithRequestLogger(func(entry RequestLogEntry) { entries = append(entries, entry) }),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Project Not Found"}`)
+	})
+
+	_, _, err = client.Projects.GetProject(1, nil)
+	if err == nil {
+		t.Fatal("Projects.GetProject returned no error")
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 log entries, got %d", len(entries))
+	}
+	for i, want := range []int{http.StatusServiceUnavailable, http.StatusNotFound} {
+		entry := entries[i]
+		if entry.Method != "GET" {
+			t.Errorf("Entry %d has method %q, want GET", i, entry.Method)
+		}
+		if entry.URL != server.URL+"/api/v4/projects/1" {
+			t.Errorf("Entry %d has URL %q, want %q", i, entry.URL, server.URL+"/api/v4/projects/1")
+		}
+		if entry.StatusCode != want {
+			t.Errorf("Entry %d has status code %d, want %d", i, entry.StatusCode, want)
+		}
+		if entry.Attempt != i+1 {
+			t.Errorf("Entry %d has attempt %d, want %d", i, entry.Attempt, i+1)
+		}
+		if entry.Duration <= 0 {
+			t.Errorf("Entry %d has duration %v, want > 0", i, entry.Duration)
+		}
+		if entry.Err != nil {
+			t.Errorf("Entry %d has error %v, want nil", i, entry.Err)
+		}
+	}
+}
+
+func TestWithRequestLoggerTransportError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	var entries []RequestLogEntry
+	client, err := NewClient("",
+		WithBaseURL(server.URL),
+		WithRequestLogger(func(entry RequestLogEntry) { entries = append(entries, entry) }),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	_, _, err = client.Projects.GetProject(1, nil)
+	if err == nil {
+		t.Fatal("Projects.GetProject returned no error")
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 log entry, got %d", len(entries))
+	}
+	if entries[0].Err == nil {
+		t.Errorf("Expected the log entry to contain an error")
+	}
+	if entries[0].StatusCode != 0 {
+		t.Errorf("Entry has status code %d, want 0", entries[0].StatusCode)
+	}
+}
+
+// testTokenSource returns the configured tokens in order and keeps
+// returning the last one once all tokens are used.
+type testTokenSource struct {
+	tokens []string
+	calls  int
+}
+
+func (ts *testTokenSource) Token() (*oauth2.Token, error) {
+	i := ts.calls
+	if i >= len(ts.tokens) {
+		i = len(ts.tokens) - 1
+	}
+	ts.calls++
+	return &oauth2.Token{AccessToken: ts.tokens[i], TokenType: "Bearer"}, nil
+}
+
+func TestWithOAuthTokenSource(t *testing.T) {
+	mux, server, _ := setup(t)
+	defer teardown(server)
+
+	ts := &testTokenSource{tokens: []string{"token-1", "token-2"}}
+	client, err := NewOAuthClient("", WithBaseURL(server.URL), WithOAuthTokenSource(ts))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	var got []string
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, _, err := client.Projects.GetProject(1, nil); err != nil {
+			t.Fatalf("Projects.GetProject returned error: %v", err)
+		}
+	}
+
+	want := []string{"Bearer token-1", "Bearer token-2"}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Expected Authorization headers %v, got %v", want, got)
+	}
+}
+
+func TestWithOAuthTokenSourceRetryUnauthorized(t *testing.T) {
+	mux, server, _ := setup(t)
+	defer teardown(server)
+
+	ts := &testTokenSource{tokens: []string{"expired", "fresh"}}
+	client, err := NewOAuthClient("", WithBaseURL(server.URL), WithOAuthTokenSource(ts))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if r.Header.Get("Authorization") != "Bearer fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"message": "401 Unauthorized"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	project, _, err := client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if project.ID != 1 {
+		t.Errorf("Projects.GetProject returned %+v", project)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestWithOAuthTokenSourceUnauthorizedSameToken(t *testing.T) {
+	mux, server, _ := setup(t)
+	defer teardown(server)
+
+	ts := &testTokenSource{tokens: []string{"revoked"}}
+	client, err := NewOAuthClient("", WithBaseURL(server.URL), WithOAuthTokenSource(ts))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	attempts := 0
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "401 Unauthorized"}`)
+	})
+
+	_, resp, err := client.Projects.GetProject(1, nil)
+	if err == nil {
+		t.Fatal("Projects.GetProject returned no error")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected a 401 response, got %+v", resp)
+	}
+	if attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestRequestWithRequestBaseURL(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	otherMux := http.NewServeMux()
+	other := httptest.NewServer(otherMux)
+	defer other.Close()
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+	otherMux.HandleFunc("/gitlab/api/v4/projects/group/project", func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, "/gitlab/api/v4/projects/group%2Fproject")
+		fmt.Fprint(w, `{"id": 2}`)
+	})
+
+	project, _, err := client.Projects.GetProject("group/project", nil, WithRequestBaseURL(other.URL+"/gitlab"))
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if project.ID != 2 {
+		t.Errorf("Expected project 2 from the overridden base URL, got %d", project.ID)
+	}
+
+	project, _, err = client.Projects.GetProject(1, nil)
+	if err != nil {
+		t.Fatalf("Projects.GetProject returned error: %v", err)
+	}
+	if project.ID != 1 {
+		t.Errorf("Expected project 1 from the client base URL, got %d", project.ID)
+	}
+
+	if _, err := client.NewRequest("GET", "projects", nil, []RequestOptionFunc{WithRequestBaseURL("not a url")}); err == nil {
+		t.Error("Expected an error for a malformed base URL")
+	}
+}
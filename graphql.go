@@ -0,0 +1,85 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+const graphQLPath = "api/graphql"
+
+// GraphQLError is returned when a GraphQL query could not (completely) be
+// executed and the response contains one or more errors.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+type GraphQLError struct {
+	Errors []*GraphQLErrorMessage
+}
+
+// GraphQLErrorMessage represents a single error returned by a GraphQL query.
+type GraphQLErrorMessage struct {
+	Message   string `json:"message"`
+	Locations []struct {
+		Line   int `json:"line"`
+		Column int `json:"column"`
+	} `json:"locations"`
+	Path []interface{} `json:"path"`
+}
+
+func (e *GraphQLError) Error() string {
+	var msgs []string
+	for _, err := range e.Errors {
+		msgs = append(msgs, err.Message)
+	}
+	return "graphql: " + strings.Join(msgs, "; ")
+}
+
+// graphQLRequest represents the body of a GraphQL request.
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// graphQLResponse represents the body of a GraphQL response.
+type graphQLResponse struct {
+	Data   json.RawMessage        `json:"data"`
+	Errors []*GraphQLErrorMessage `json:"errors"`
+}
+
+// GraphQL executes a query (or mutation) against the GraphQL API of the
+// GitLab instance using the same authentication and transport as all other
+// requests. The data field of the response is JSON decoded into out. If the
+// response contains any errors, a *GraphQLError is returned. In that case
+// the partial data (if any) is still decoded into out.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/graphql/
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}, options ...RequestOptionFunc) (*Response, error) {
+	opt := &graphQLRequest{Query: query, Variables: variables}
+
+	req, err := c.NewRequest("POST", "", opt, append([]RequestOptionFunc{WithContext(ctx)}, options...))
+	if err != nil {
+		return nil, err
+	}
+
+	// The GraphQL endpoint is not part of the versioned REST API.
+	req.URL.Path = strings.TrimSuffix(req.URL.Path, apiVersionPath) + graphQLPath
+	req.URL.RawPath = ""
+
+	var gr graphQLResponse
+	resp, err := c.Do(req, &gr)
+	if err != nil {
+		return resp, err
+	}
+
+	if out != nil && len(gr.Data) > 0 && string(gr.Data) != "null" {
+		if err := json.Unmarshal(gr.Data, out); err != nil {
+			return resp, err
+		}
+	}
+
+	if len(gr.Errors) > 0 {
+		return resp, &GraphQLError{Errors: gr.Errors}
+	}
+
+	return resp, nil
+}
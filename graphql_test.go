@@ -0,0 +1,65 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestGraphQL(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"query":"query($path: ID!) { project(fullPath: $path) { name } }","variables":{"path":"gitlab-org/gitlab"}}`)
+		fmt.Fprint(w, `{"data": {"project": {"name": "GitLab"}}}`)
+	})
+
+	var out struct {
+		Project struct {
+			Name string `json:"name"`
+		} `json:"project"`
+	}
+
+	_, err := client.GraphQL(
+		context.Background(),
+		"query($path: ID!) { project(fullPath: $path) { name } }",
+		map[string]interface{}{"path": "gitlab-org/gitlab"},
+		&out,
+	)
+	if err != nil {
+		t.Fatalf("GraphQL returned error: %v", err)
+	}
+	if out.Project.Name != "GitLab" {
+		t.Errorf("GraphQL returned project name %q, want %q", out.Project.Name, "GitLab")
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/graphql", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"data": null, "errors": [{"message": "Field 'foo' doesn't exist on type 'Query'", "locations": [{"line": 1, "column": 3}], "path": ["query", "foo"]}]}`)
+	})
+
+	var out map[string]interface{}
+	_, err := client.GraphQL(context.Background(), "{ foo }", nil, &out)
+
+	var gqlErr *GraphQLError
+	if !errors.As(err, &gqlErr) {
+		t.Fatalf("GraphQL returned error %v, want *GraphQLError", err)
+	}
+	if len(gqlErr.Errors) != 1 || gqlErr.Errors[0].Locations[0].Column != 3 {
+		t.Errorf("GraphQL returned unexpected errors: %+v", gqlErr.Errors)
+	}
+
+	want := "graphql: Field 'foo' doesn't exist on type 'Query'"
+	if err.Error() != want {
+		t.Errorf("GraphQL returned error %q, want %q", err.Error(), want)
+	}
+}
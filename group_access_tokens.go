@@ -0,0 +1,161 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// GroupAccessTokensService handles communication with the
+// group access tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_access_tokens.html
+type GroupAccessTokensService struct {
+	client *Client
+}
+
+// GroupAccessToken represents a GitLab group access token. The Token
+// field is only set in the responses of CreateGroupAccessToken and
+// RotateGroupAccessToken, it is never returned again afterwards.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/group_access_tokens.html
+type GroupAccessToken struct {
+	ID          int              `json:"id"`
+	UserID      int              `json:"user_id"`
+	Name        string           `json:"name"`
+	Scopes      []string         `json:"scopes"`
+	CreatedAt   *time.Time       `json:"created_at"`
+	LastUsedAt  *time.Time       `json:"last_used_at"`
+	ExpiresAt   *ISOTime         `json:"expires_at"`
+	Active      bool             `json:"active"`
+	Revoked     bool             `json:"revoked"`
+	Token       string           `json:"token"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+}
+
+func (v GroupAccessToken) String() string {
+	return Stringify(v)
+}
+
+// ListGroupAccessTokensOptions represents the available
+// ListGroupAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens
+type ListGroupAccessTokensOptions ListOptions
+
+// ListGroupAccessTokens gets a list of all group access tokens in a group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#list-group-access-tokens
+func (s *GroupAccessTokensService) ListGroupAccessTokens(gid interface{}, opt *ListGroupAccessTokensOptions, options ...RequestOptionFunc) ([]*GroupAccessToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/access_tokens", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gats []*GroupAccessToken
+	resp, err := s.client.Do(req, &gats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gats, resp, err
+}
+
+// CreateGroupAccessTokenOptions represents the available
+// CreateGroupAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#create-a-group-access-token
+type CreateGroupAccessTokenOptions struct {
+	Name        *string           `url:"name,omitempty" json:"name,omitempty"`
+	Scopes      *[]string         `url:"scopes,omitempty" json:"scopes,omitempty"`
+	AccessLevel *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+	ExpiresAt   *ISOTime          `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// CreateGroupAccessToken creates a new group access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#create-a-group-access-token
+func (s *GroupAccessTokensService) CreateGroupAccessToken(gid interface{}, opt *CreateGroupAccessTokenOptions, options ...RequestOptionFunc) (*GroupAccessToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/access_tokens", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gat := new(GroupAccessToken)
+	resp, err := s.client.Do(req, gat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gat, resp, err
+}
+
+// RotateGroupAccessTokenOptions represents the available
+// RotateGroupAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#rotate-a-group-access-token
+type RotateGroupAccessTokenOptions struct {
+	ExpiresAt *ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// RotateGroupAccessToken revokes a group access token and returns a new
+// one. The new token value is only available in the returned
+// GroupAccessToken.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#rotate-a-group-access-token
+func (s *GroupAccessTokensService) RotateGroupAccessToken(gid interface{}, tokenID int, opt *RotateGroupAccessTokenOptions, options ...RequestOptionFunc) (*GroupAccessToken, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/access_tokens/%d/rotate", pathEscape(group), tokenID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gat := new(GroupAccessToken)
+	resp, err := s.client.Do(req, gat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gat, resp, err
+}
+
+// RevokeGroupAccessToken revokes a group access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_access_tokens.html#revoke-a-group-access-token
+func (s *GroupAccessTokensService) RevokeGroupAccessToken(gid interface{}, tokenID int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/access_tokens/%d", pathEscape(group), tokenID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,48 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListGroupAccessTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1876, "user_id": 2453, "name": "token 10", "scopes": ["read_api"], "active": true, "access_level": 10}]`)
+	})
+
+	gats, _, err := client.GroupAccessTokens.ListGroupAccessTokens(1, nil)
+	require.NoError(t, err)
+
+	want := []*GroupAccessToken{{
+		ID:          1876,
+		UserID:      2453,
+		Name:        "token 10",
+		Scopes:      []string{"read_api"},
+		Active:      true,
+		AccessLevel: GuestPermissions,
+	}}
+	assert.Equal(t, want, gats)
+}
+
+func TestRotateGroupAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 43, "name": "ci", "active": true, "token": "s3cr3t"}`)
+	})
+
+	gat, _, err := client.GroupAccessTokens.RotateGroupAccessToken(1, 42, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, &GroupAccessToken{ID: 43, Name: "ci", Active: true, Token: "s3cr3t"}, gat)
+}
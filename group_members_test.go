@@ -0,0 +1,139 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInheritedGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/all/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "username": "john_doe", "access_level": 40}`)
+	})
+
+	member, _, err := client.GroupMembers.GetInheritedGroupMember(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &GroupMember{ID: 2, Username: "john_doe", AccessLevel: MaintainerPermissions}, member)
+}
+
+func TestListBillableGroupMembers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/billable_members?search=john&sort=last_activity_on_desc")
+		fmt.Fprint(w, `[{
+			"id": 2,
+			"username": "john_doe",
+			"name": "John Doe",
+			"state": "active",
+			"email": "john@example.com",
+			"last_activity_on": "2021-01-27",
+			"membership_type": "group_member",
+			"removable": true,
+			"created_at": "2021-01-03T12:16:02Z",
+			"is_last_owner": false
+		}]`)
+	})
+
+	opt := &ListBillableGroupMembersOptions{Search: String("john"), Sort: String("last_activity_on_desc")}
+	members, _, err := client.Groups.ListBillableGroupMembers(1, opt)
+	require.NoError(t, err)
+
+	lastActivityOn := ISOTime(time.Date(2021, time.January, 27, 0, 0, 0, 0, time.UTC))
+	createdAt := time.Date(2021, time.January, 3, 12, 16, 2, 0, time.UTC)
+	want := []*BillableGroupMember{{
+		ID:             2,
+		Username:       "john_doe",
+		Name:           "John Doe",
+		State:          "active",
+		Email:          "john@example.com",
+		LastActivityOn: &lastActivityOn,
+		MembershipType: "group_member",
+		Removable:      true,
+		CreatedAt:      &createdAt,
+	}}
+	assert.Equal(t, want, members)
+}
+
+func TestListMembershipsForBillableGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/billable_members/2/memberships", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"id": 168,
+			"source_id": 131,
+			"source_full_name": "Root Group / Sub Group One",
+			"source_members_url": "https://gitlab.example.com/groups/root-group/sub-group-one/-/group_members",
+			"access_level": {"string_value": "Developer", "integer_value": 30}
+		}]`)
+	})
+
+	memberships, _, err := client.Groups.ListMembershipsForBillableGroupMember(1, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, memberships, 1)
+
+	m := memberships[0]
+	assert.Equal(t, 168, m.ID)
+	assert.Equal(t, 131, m.SourceID)
+	assert.Equal(t, "Root Group / Sub Group One", m.SourceFullName)
+	assert.Equal(t, "Developer", m.AccessLevel.StringValue)
+	assert.Equal(t, DeveloperPermissions, m.AccessLevel.IntegerValue)
+}
+
+func TestEditGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"access_level":30,"expires_at":"2030-06-30","member_role_id":7}`)
+		fmt.Fprint(w, `{"id": 2, "username": "contractor", "access_level": 30, "expires_at": "2030-06-30"}`)
+	})
+
+	opt := &EditGroupMemberOptions{
+		AccessLevel:  AccessLevel(DeveloperPermissions),
+		ExpiresAt:    String("2030-06-30"),
+		MemberRoleID: Int(7),
+	}
+	member, _, err := client.GroupMembers.EditGroupMember(1, 2, opt)
+	require.NoError(t, err)
+
+	expiresAt := ISOTime(time.Date(2030, time.June, 30, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, &GroupMember{ID: 2, Username: "contractor", AccessLevel: DeveloperPermissions, ExpiresAt: &expiresAt}, member)
+}
+
+func TestOverrideGroupMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/members/2/override", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"id": 2, "access_level": 30, "override": true}`)
+		case http.MethodDelete:
+			fmt.Fprint(w, `{"id": 2, "access_level": 30, "override": false}`)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+
+	member, _, err := client.GroupMembers.OverrideGroupMember(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &GroupMember{ID: 2, AccessLevel: DeveloperPermissions, Override: true}, member)
+
+	member, _, err = client.GroupMembers.DeleteGroupMemberOverride(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &GroupMember{ID: 2, AccessLevel: DeveloperPermissions}, member)
+}
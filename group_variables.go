@@ -17,6 +17,7 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -35,11 +36,13 @@ type GroupVariablesService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_level_variables.html
 type GroupVariable struct {
-	Key          string            `json:"key"`
-	Value        string            `json:"value"`
-	VariableType VariableTypeValue `json:"variable_type"`
-	Protected    bool              `json:"protected"`
-	Masked       bool              `json:"masked"`
+	Key              string            `json:"key"`
+	Value            string            `json:"value"`
+	VariableType     VariableTypeValue `json:"variable_type"`
+	Protected        bool              `json:"protected"`
+	Masked           bool              `json:"masked"`
+	Raw              bool              `json:"raw"`
+	EnvironmentScope string            `json:"environment_scope"`
 }
 
 func (v GroupVariable) String() string {
@@ -78,6 +81,43 @@ func (s *GroupVariablesService) ListVariables(gid interface{}, opt *ListGroupVar
 	return vs, resp, err
 }
 
+// ListAllGroupVariablesOptions represents the available ListAllVariables()
+// options. GitLab does not filter variables by environment scope, so the
+// EnvironmentScope filter is applied by the client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#list-group-variables
+type ListAllGroupVariablesOptions struct {
+	EnvironmentScope *string `url:"-" json:"-"`
+}
+
+// ListAllVariables gets all variables of a group, fetching every page of
+// results using Scan. The returned Response is the one of the last page.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_level_variables.html#list-group-variables
+func (s *GroupVariablesService) ListAllVariables(gid interface{}, opt *ListAllGroupVariablesOptions, options ...RequestOptionFunc) ([]*GroupVariable, *Response, error) {
+	var last *Response
+	items, errs := Scan(context.Background(), func(lo ListOptions, opts ...RequestOptionFunc) ([]*GroupVariable, *Response, error) {
+		lo.PerPage = 100
+		vs, resp, err := s.ListVariables(gid, (*ListGroupVariablesOptions)(&lo), append(options, opts...)...)
+		last = resp
+		return vs, resp, err
+	})
+
+	var all []*GroupVariable
+	for v := range items {
+		if opt == nil || opt.EnvironmentScope == nil || v.EnvironmentScope == *opt.EnvironmentScope {
+			all = append(all, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, last, err
+	}
+
+	return all, last, nil
+}
+
 // GetVariable gets a variable.
 //
 // GitLab API docs:
@@ -109,11 +149,13 @@ func (s *GroupVariablesService) GetVariable(gid interface{}, key string, options
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_level_variables.html#create-variable
 type CreateGroupVariableOptions struct {
-	Key          *string            `url:"key,omitempty" json:"key,omitempty"`
-	Value        *string            `url:"value,omitempty" json:"value,omitempty"`
-	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
-	Protected    *bool              `url:"protected,omitempty" json:"protected,omitempty"`
-	Masked       *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Key              *string            `url:"key,omitempty" json:"key,omitempty"`
+	Value            *string            `url:"value,omitempty" json:"value,omitempty"`
+	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Protected        *bool              `url:"protected,omitempty" json:"protected,omitempty"`
+	Masked           *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw              *bool              `url:"raw,omitempty" json:"raw,omitempty"`
+	EnvironmentScope *string            `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 }
 
 // CreateVariable creates a new group variable.
@@ -147,10 +189,12 @@ func (s *GroupVariablesService) CreateVariable(gid interface{}, opt *CreateGroup
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/group_level_variables.html#update-variable
 type UpdateGroupVariableOptions struct {
-	Value        *string            `url:"value,omitempty" json:"value,omitempty"`
-	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
-	Protected    *bool              `url:"protected,omitempty" json:"protected,omitempty"`
-	Masked       *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Value            *string            `url:"value,omitempty" json:"value,omitempty"`
+	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Protected        *bool              `url:"protected,omitempty" json:"protected,omitempty"`
+	Masked           *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw              *bool              `url:"raw,omitempty" json:"raw,omitempty"`
+	EnvironmentScope *string            `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 }
 
 // UpdateVariable updates the position of an existing
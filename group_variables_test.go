@@ -86,6 +86,35 @@ func TestCreateGroupVariable(t *testing.T) {
 	}
 }
 
+func TestCreateGroupVariableWithEnvironmentScope(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/variables",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"key":"TEST_VARIABLE_1","value":"test1","raw":true,"environment_scope":"production"}`)
+			fmt.Fprint(w, `{"key": "TEST_VARIABLE_1","value": "test1","protected": false,"masked": false,"raw": true,"environment_scope": "production"}`)
+		})
+
+	opt := &CreateGroupVariableOptions{
+		Key:              String("TEST_VARIABLE_1"),
+		Value:            String("test1"),
+		Raw:              Bool(true),
+		EnvironmentScope: String("production"),
+	}
+
+	variable, _, err := client.GroupVariables.CreateVariable(1, opt)
+	if err != nil {
+		t.Errorf("GroupVariables.CreateVariable returned error: %v", err)
+	}
+
+	want := &GroupVariable{Key: "TEST_VARIABLE_1", Value: "test1", Raw: true, EnvironmentScope: "production"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("GroupVariables.CreateVariable returned %+v, want %+v", variable, want)
+	}
+}
+
 func TestDeleteGroupVariable(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -128,3 +157,32 @@ func TestUpdateGroupVariable(t *testing.T) {
 		t.Errorf("Groups.UpdatedGroup returned %+v, want %+v", variable, want)
 	}
 }
+
+func TestListAllGroupVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[{"key": "TEST_VARIABLE_1", "value": "test1", "masked": true}]`)
+		case "2":
+			fmt.Fprint(w, `[{"key": "TEST_VARIABLE_2", "value": "test2", "protected": true}]`)
+		}
+	})
+
+	variables, _, err := client.GroupVariables.ListAllVariables(1, nil)
+	if err != nil {
+		t.Errorf("GroupVariables.ListAllVariables returned error: %v", err)
+	}
+
+	want := []*GroupVariable{
+		{Key: "TEST_VARIABLE_1", Value: "test1", Masked: true},
+		{Key: "TEST_VARIABLE_2", Value: "test2", Protected: true},
+	}
+	if !reflect.DeepEqual(want, variables) {
+		t.Errorf("GroupVariables.ListAllVariables returned %+v, want %+v", variables, want)
+	}
+}
@@ -1,9 +1,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -49,6 +51,28 @@ func TestGetGroup(t *testing.T) {
 	}
 }
 
+func TestGetGroupStorageStatistics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/g",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testURL(t, r, "/api/v4/groups/g?statistics=true")
+			fmt.Fprint(w, `{"id": 1, "name": "g", "statistics": {"storage_size": 5368709120000, "repository_size": 2048, "wiki_size": 1024, "packages_size": 512}}`)
+		})
+
+	stats, _, err := client.Groups.GetGroupStorageStatistics("g")
+	if err != nil {
+		t.Errorf("Groups.GetGroupStorageStatistics returned error: %v", err)
+	}
+
+	want := &StorageStatistics{StorageSize: 5368709120000, RepositorySize: 2048, WikiSize: 1024, PackagesSize: 512}
+	if !reflect.DeepEqual(want, stats) {
+		t.Errorf("Groups.GetGroupStorageStatistics returned %+v, want %+v", stats, want)
+	}
+}
+
 func TestCreateGroup(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -97,6 +121,45 @@ func TestTransferGroup(t *testing.T) {
 
 }
 
+func TestTransferSubGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/transfer",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"group_id":2}`)
+			fmt.Fprintf(w, `{"id": 1, "parent_id": 2}`)
+		})
+
+	group, _, err := client.Groups.TransferSubGroup(1, &TransferSubGroupOptions{GroupID: Int(2)})
+	if err != nil {
+		t.Errorf("Groups.TransferSubGroup returned error: %v", err)
+	}
+
+	want := &Group{ID: 1, ParentID: 2}
+	if !reflect.DeepEqual(group, want) {
+		t.Errorf("Groups.TransferSubGroup returned %+v, want %+v", group, want)
+	}
+}
+
+func TestTransferSubGroupPathTaken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/transfer",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"message": "Transfer failed: The parent group already has a subgroup or a project with the same path."}`)
+		})
+
+	_, _, err := client.Groups.TransferSubGroup(1, &TransferSubGroupOptions{GroupID: Int(2)})
+	if !errors.Is(err, ErrTransferPathTaken) {
+		t.Errorf("Groups.TransferSubGroup returned error %v, want %v", err, ErrTransferPathTaken)
+	}
+}
+
 func TestDeleteGroup(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -282,3 +345,97 @@ func TestAddGroupLDAPLink(t *testing.T) {
 		t.Errorf("Groups.AddGroupLDAPLink returned %+v, want %+v", link, want)
 	}
 }
+
+func TestGetGroupPushRules(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "author_email_regex": "@company.com$", "file_name_regex": "\\.exe$", "max_file_size": 100}`)
+	})
+
+	rule, _, err := client.Groups.GetGroupPushRules(1)
+	if err != nil {
+		t.Errorf("Groups.GetGroupPushRules returned error: %v", err)
+	}
+
+	want := &GroupPushRules{ID: 2, AuthorEmailRegex: "@company.com$", FileNameRegex: `\.exe$`, MaxFileSize: 100}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("Groups.GetGroupPushRules returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestGetGroupPushRulesNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Push Rule Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+	mux.HandleFunc("/api/v4/groups/2/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Group Not Found"}`)
+	})
+
+	_, resp, err := client.Groups.GetGroupPushRules(1)
+	if !errors.Is(err, ErrPushRuleNotFound) {
+		t.Errorf("Groups.GetGroupPushRules returned error %v, want %v", err, ErrPushRuleNotFound)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || !strings.Contains(errResp.Message, "404 Push Rule Not Found") {
+		t.Errorf("Groups.GetGroupPushRules returned error %v, want it to wrap the 404 ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Groups.GetGroupPushRules returned response %+v, want status 404", resp)
+	}
+
+	_, _, err = client.Groups.GetGroupPushRules(2)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Groups.GetGroupPushRules returned error %v, want *ErrorResponse", err)
+	}
+}
+
+func TestAddGroupPushRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"prevent_secrets":true,"commit_message_regex":"JIRA-\\d+"}`)
+		fmt.Fprint(w, `{"id": 2, "prevent_secrets": true, "commit_message_regex": "JIRA-\\d+"}`)
+	})
+
+	opt := &AddGroupPushRuleOptions{
+		PreventSecrets:     Bool(true),
+		CommitMessageRegex: String(`JIRA-\d+`),
+	}
+	rule, _, err := client.Groups.AddGroupPushRule(1, opt)
+	if err != nil {
+		t.Errorf("Groups.AddGroupPushRule returned error: %v", err)
+	}
+
+	want := &GroupPushRules{ID: 2, PreventSecrets: true, CommitMessageRegex: `JIRA-\d+`}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("Groups.AddGroupPushRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestDeleteGroupPushRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Groups.DeleteGroupPushRule(1)
+	if err != nil {
+		t.Errorf("Groups.DeleteGroupPushRule returned error: %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ErrInstanceVariablesForbidden is returned by the InstanceVariablesService
+// when GitLab rejects a request with 403 Forbidden, because the user isn't an
+// administrator. It wraps the ErrorResponse of GitLab, so check for it using
+// errors.Is.
+var ErrInstanceVariablesForbidden = errors.New("Instance variables API requires administrator access")
+
+// InstanceVariablesService handles communication with the
+// instance level CI variables related methods of the GitLab API.
+//
+// All methods of this service require administrator access. For other users
+// ErrInstanceVariablesForbidden is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html
+type InstanceVariablesService struct {
+	client *Client
+}
+
+// InstanceVariable represents a GitLab instance level CI Variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html
+type InstanceVariable struct {
+	Key          string            `json:"key"`
+	Value        string            `json:"value"`
+	VariableType VariableTypeValue `json:"variable_type"`
+	Protected    bool              `json:"protected"`
+	Masked       bool              `json:"masked"`
+	Raw          bool              `json:"raw"`
+}
+
+func (v InstanceVariable) String() string {
+	return Stringify(v)
+}
+
+// ListInstanceVariablesOptions represents the available options for listing
+// the instance level CI variables.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#list-all-instance-variables
+type ListInstanceVariablesOptions ListOptions
+
+// ListVariables gets a list of all instance level CI variables.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#list-all-instance-variables
+func (s *InstanceVariablesService) ListVariables(opt *ListInstanceVariablesOptions, options ...RequestOptionFunc) ([]*InstanceVariable, *Response, error) {
+	req, err := s.client.NewRequest("GET", "admin/ci/variables", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vs []*InstanceVariable
+	resp, err := s.client.Do(req, &vs)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrInstanceVariablesForbidden)
+	}
+
+	return vs, resp, err
+}
+
+// GetVariable gets an instance level CI variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#show-instance-variable-details
+func (s *InstanceVariablesService) GetVariable(key string, options ...RequestOptionFunc) (*InstanceVariable, *Response, error) {
+	u := fmt.Sprintf("admin/ci/variables/%s", url.PathEscape(key))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(InstanceVariable)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrInstanceVariablesForbidden)
+	}
+
+	return v, resp, err
+}
+
+// CreateInstanceVariableOptions represents the available CreateVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#create-instance-variable
+type CreateInstanceVariableOptions struct {
+	Key          *string            `url:"key,omitempty" json:"key,omitempty"`
+	Value        *string            `url:"value,omitempty" json:"value,omitempty"`
+	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Protected    *bool              `url:"protected,omitempty" json:"protected,omitempty"`
+	Masked       *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw          *bool              `url:"raw,omitempty" json:"raw,omitempty"`
+}
+
+// CreateVariable creates a new instance level CI variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#create-instance-variable
+func (s *InstanceVariablesService) CreateVariable(opt *CreateInstanceVariableOptions, options ...RequestOptionFunc) (*InstanceVariable, *Response, error) {
+	req, err := s.client.NewRequest("POST", "admin/ci/variables", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(InstanceVariable)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrInstanceVariablesForbidden)
+	}
+
+	return v, resp, err
+}
+
+// UpdateInstanceVariableOptions represents the available UpdateVariable()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#update-instance-variable
+type UpdateInstanceVariableOptions struct {
+	Value        *string            `url:"value,omitempty" json:"value,omitempty"`
+	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+	Protected    *bool              `url:"protected,omitempty" json:"protected,omitempty"`
+	Masked       *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw          *bool              `url:"raw,omitempty" json:"raw,omitempty"`
+}
+
+// UpdateVariable updates an existing instance level CI variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#update-instance-variable
+func (s *InstanceVariablesService) UpdateVariable(key string, opt *UpdateInstanceVariableOptions, options ...RequestOptionFunc) (*InstanceVariable, *Response, error) {
+	u := fmt.Sprintf("admin/ci/variables/%s", url.PathEscape(key))
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	v := new(InstanceVariable)
+	resp, err := s.client.Do(req, v)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrInstanceVariablesForbidden)
+	}
+
+	return v, resp, err
+}
+
+// RemoveVariable removes an instance level CI variable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/instance_level_ci_variables.html#remove-instance-variable
+func (s *InstanceVariablesService) RemoveVariable(key string, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("admin/ci/variables/%s", url.PathEscape(key))
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrInstanceVariablesForbidden)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,121 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListInstanceVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"key": "TEST_VARIABLE_1", "variable_type": "env_var", "value": "test1", "protected": false, "masked": true, "raw": false}]`)
+	})
+
+	variables, _, err := client.InstanceVariables.ListVariables(&ListInstanceVariablesOptions{})
+	require.NoError(t, err)
+
+	want := []*InstanceVariable{{Key: "TEST_VARIABLE_1", Value: "test1", VariableType: EnvVariableType, Masked: true}}
+	assert.Equal(t, want, variables)
+}
+
+func TestGetInstanceVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables/TEST_VARIABLE_1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"key": "TEST_VARIABLE_1", "variable_type": "file", "value": "test1", "protected": true, "masked": false, "raw": true}`)
+	})
+
+	variable, _, err := client.InstanceVariables.GetVariable("TEST_VARIABLE_1")
+	require.NoError(t, err)
+
+	want := &InstanceVariable{Key: "TEST_VARIABLE_1", Value: "test1", VariableType: FileVariableType, Protected: true, Raw: true}
+	assert.Equal(t, want, variable)
+}
+
+func TestCreateInstanceVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"NEW_VARIABLE","value":"new value","variable_type":"env_var","protected":true,"masked":true,"raw":true}`)
+		fmt.Fprint(w, `{"key": "NEW_VARIABLE", "variable_type": "env_var", "value": "new value", "protected": true, "masked": true, "raw": true}`)
+	})
+
+	opt := &CreateInstanceVariableOptions{
+		Key:          String("NEW_VARIABLE"),
+		Value:        String("new value"),
+		VariableType: VariableType(EnvVariableType),
+		Protected:    Bool(true),
+		Masked:       Bool(true),
+		Raw:          Bool(true),
+	}
+	variable, _, err := client.InstanceVariables.CreateVariable(opt)
+	require.NoError(t, err)
+
+	want := &InstanceVariable{Key: "NEW_VARIABLE", Value: "new value", VariableType: EnvVariableType, Protected: true, Masked: true, Raw: true}
+	assert.Equal(t, want, variable)
+}
+
+func TestUpdateInstanceVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables/NEW_VARIABLE", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"value":"updated value","protected":false}`)
+		fmt.Fprint(w, `{"key": "NEW_VARIABLE", "variable_type": "env_var", "value": "updated value", "protected": false, "masked": false, "raw": false}`)
+	})
+
+	opt := &UpdateInstanceVariableOptions{Value: String("updated value"), Protected: Bool(false)}
+	variable, _, err := client.InstanceVariables.UpdateVariable("NEW_VARIABLE", opt)
+	require.NoError(t, err)
+
+	want := &InstanceVariable{Key: "NEW_VARIABLE", Value: "updated value", VariableType: EnvVariableType}
+	assert.Equal(t, want, variable)
+}
+
+func TestRemoveInstanceVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables/NEW_VARIABLE", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.InstanceVariables.RemoveVariable("NEW_VARIABLE")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestListInstanceVariablesForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/admin/ci/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+	})
+
+	variables, resp, err := client.InstanceVariables.ListVariables(nil)
+	assert.Nil(t, variables)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.True(t, errors.Is(err, ErrInstanceVariablesForbidden), "expected ErrInstanceVariablesForbidden, got %v", err)
+
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	assert.Equal(t, "{message: 403 Forbidden}", errResp.Message)
+}
@@ -34,10 +34,12 @@ type IssueLinksService struct {
 type IssueLink struct {
 	SourceIssue *Issue `json:"source_issue"`
 	TargetIssue *Issue `json:"target_issue"`
+	LinkType    string `json:"link_type"`
 }
 
 // ListIssueRelations gets a list of related issues of a given issue,
-// sorted by the relationship creation datetime (ascending).
+// sorted by the relationship creation datetime (ascending). The IssueLinkID
+// and LinkType of the returned issues describe the relation.
 //
 // Issues will be filtered according to the user authorizations.
 //
@@ -65,11 +67,13 @@ func (s *IssueLinksService) ListIssueRelations(pid interface{}, issueIID int, op
 }
 
 // CreateIssueLinkOptions represents the available CreateIssueLink() options.
+// LinkType is one of "relates_to", "blocks" or "is_blocked_by".
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issue_links.html
 type CreateIssueLinkOptions struct {
 	TargetProjectID *string `json:"target_project_id"`
 	TargetIssueIID  *string `json:"target_issue_iid"`
+	LinkType        *string `json:"link_type,omitempty"`
 }
 
 // CreateIssueLink creates a two-way relation between two issues.
@@ -0,0 +1,86 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListIssueRelations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/4/issues/14/links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":84,"iid":14,"project_id":4,"issue_link_id":1,"link_type":"blocks"}]`)
+	})
+
+	issues, _, err := client.IssueLinks.ListIssueRelations(4, 14)
+	if err != nil {
+		t.Fatalf("IssueLinks.ListIssueRelations returned error: %v", err)
+	}
+
+	want := []*Issue{{ID: 84, IID: 14, ProjectID: 4, IssueLinkID: 1, LinkType: "blocks"}}
+	if !reflect.DeepEqual(want, issues) {
+		t.Errorf("IssueLinks.ListIssueRelations returned %+v, want %+v", issues, want)
+	}
+}
+
+func TestCreateIssueLink(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/4/issues/1/links", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"target_project_id":"5","target_issue_iid":"6","link_type":"is_blocked_by"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"source_issue": {"id":83,"iid":1,"project_id":4},
+			"target_issue": {"id":84,"iid":6,"project_id":5},
+			"link_type": "is_blocked_by"
+		}`)
+	})
+
+	opt := &CreateIssueLinkOptions{
+		TargetProjectID: String("5"),
+		TargetIssueIID:  String("6"),
+		LinkType:        String("is_blocked_by"),
+	}
+	link, _, err := client.IssueLinks.CreateIssueLink(4, 1, opt)
+	if err != nil {
+		t.Fatalf("IssueLinks.CreateIssueLink returned error: %v", err)
+	}
+
+	want := &IssueLink{
+		SourceIssue: &Issue{ID: 83, IID: 1, ProjectID: 4},
+		TargetIssue: &Issue{ID: 84, IID: 6, ProjectID: 5},
+		LinkType:    "is_blocked_by",
+	}
+	if !reflect.DeepEqual(want, link) {
+		t.Errorf("IssueLinks.CreateIssueLink returned %+v, want %+v", link, want)
+	}
+}
+
+func TestDeleteIssueLink(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/4/issues/1/links/83", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{
+			"source_issue": {"id":83,"iid":1,"project_id":4},
+			"target_issue": {"id":84,"iid":6,"project_id":5},
+			"link_type": "relates_to"
+		}`)
+	})
+
+	link, _, err := client.IssueLinks.DeleteIssueLink(4, 1, 83)
+	if err != nil {
+		t.Fatalf("IssueLinks.DeleteIssueLink returned error: %v", err)
+	}
+
+	if link.LinkType != "relates_to" || link.TargetIssue == nil || link.TargetIssue.ID != 84 {
+		t.Errorf("IssueLinks.DeleteIssueLink returned %+v", link)
+	}
+}
@@ -18,12 +18,25 @@ package gitlab
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
 )
 
+var (
+	// ErrIssueTargetIsSource is returned by MoveIssue when the target project
+	// is the project the issue already belongs to. Both errors
+	// wrap the ErrorResponse of GitLab, so check for them using errors.Is.
+	ErrIssueTargetIsSource = errors.New("Target project is the source project of the issue")
+
+	// ErrIssueTargetForbidden is returned by MoveIssue and CloneIssue when the
+	// user is not allowed to create issues in the target project.
+	ErrIssueTargetForbidden = errors.New("Insufficient permissions in the target project")
+)
+
 // IssuesService handles communication with the issue related methods
 // of the GitLab API.
 //
@@ -111,6 +124,8 @@ type Issue struct {
 	UserNotesCount       int              `json:"user_notes_count"`
 	Links                *IssueLinks      `json:"_links"`
 	IssueLinkID          int              `json:"issue_link_id"`
+	LinkType             string           `json:"link_type"`
+	LinkCreatedAt        *time.Time       `json:"link_created_at"`
 	MergeRequestCount    int              `json:"merge_requests_count"`
 	EpicIssueID          int              `json:"epic_issue_id"`
 	TaskCompletionStatus struct {
@@ -458,8 +473,9 @@ type MoveIssueOptions struct {
 	ToProjectID *int `url:"to_project_id,omitempty" json:"to_project_id,omitempty"`
 }
 
-// MoveIssue updates an existing project issue. This function is also used
-// to mark an issue as closed.
+// MoveIssue moves an issue to another project and returns the new issue in
+// the target project. ErrIssueTargetIsSource or ErrIssueTargetForbidden are
+// returned when GitLab refuses the move for one of those reasons.
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#move-an-issue
 func (s *IssuesService) MoveIssue(pid interface{}, issue int, opt *MoveIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
@@ -477,18 +493,63 @@ func (s *IssuesService) MoveIssue(pid interface{}, issue int, opt *MoveIssueOpti
 	i := new(Issue)
 	resp, err := s.client.Do(req, i)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, issueTransferError(err)
+	}
+
+	return i, resp, err
+}
+
+// CloneIssueOptions represents the available CloneIssue() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#clone-an-issue
+type CloneIssueOptions struct {
+	ToProjectID *int  `url:"to_project_id,omitempty" json:"to_project_id,omitempty"`
+	WithNotes   *bool `url:"with_notes,omitempty" json:"with_notes,omitempty"`
+}
+
+// CloneIssue clones an issue to another project and returns the new issue in
+// the target project. ErrIssueTargetForbidden is returned when the user is not
+// allowed to create issues in the target project.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/issues.html#clone-an-issue
+func (s *IssuesService) CloneIssue(pid interface{}, issue int, opt *CloneIssueOptions, options ...RequestOptionFunc) (*Issue, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d/clone", pathEscape(project), issue)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	i := new(Issue)
+	resp, err := s.client.Do(req, i)
+	if err != nil {
+		return nil, resp, issueTransferError(err)
 	}
 
 	return i, resp, err
 }
 
+// issueTransferError wraps the errors GitLab returns when moving or cloning
+// an issue fails with the matching error variables. GitLab reports most of
+// these failures as a 400 with a descriptive message. A 403 is only related
+// to the target project when its message says so.
+func issueTransferError(err error) error {
+	err = wrapErrorResponse(err, http.StatusBadRequest, "originates from", ErrIssueTargetIsSource)
+	err = wrapErrorResponse(err, http.StatusBadRequest, "insufficient permissions", ErrIssueTargetForbidden)
+	return wrapErrorResponse(err, http.StatusForbidden, "target project", ErrIssueTargetForbidden)
+}
+
 // SubscribeToIssue subscribes the authenticated user to the given issue to
-// receive notifications. If the user is already subscribed to the issue, the
-// status code 304 is returned.
+// receive notifications. If the user is already subscribed to the issue, no
+// error and a nil issue are returned, and the status code of the response is
+// http.StatusNotModified.
 //
 // GitLab API docs:
-// https://docs.gitlab.com/ce/api/merge_requests.html#subscribe-to-a-merge-request
+// https://docs.gitlab.com/ce/api/issues.html#subscribe-to-an-issue
 func (s *IssuesService) SubscribeToIssue(pid interface{}, issue int, options ...RequestOptionFunc) (*Issue, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
@@ -507,15 +568,20 @@ func (s *IssuesService) SubscribeToIssue(pid interface{}, issue int, options ...
 		return nil, resp, err
 	}
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+
 	return i, resp, err
 }
 
 // UnsubscribeFromIssue unsubscribes the authenticated user from the given
-// issue to not receive notifications from that merge request. If the user
-// is not subscribed to the issue, status code 304 is returned.
+// issue to not receive notifications from that issue. If the user is not
+// subscribed to the issue, no error and a nil issue are returned, and the
+// status code of the response is http.StatusNotModified.
 //
 // GitLab API docs:
-// https://docs.gitlab.com/ce/api/merge_requests.html#unsubscribe-from-a-merge-request
+// https://docs.gitlab.com/ce/api/issues.html#unsubscribe-from-an-issue
 func (s *IssuesService) UnsubscribeFromIssue(pid interface{}, issue int, options ...RequestOptionFunc) (*Issue, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
@@ -534,9 +600,45 @@ func (s *IssuesService) UnsubscribeFromIssue(pid interface{}, issue int, options
 		return nil, resp, err
 	}
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+
 	return i, resp, err
 }
 
+// CreateTodo manually creates a todo for the current user on an issue.
+// If there already exists a todo for the user on that issue, no error and a
+// nil todo are returned, and the status code of the response is
+// http.StatusNotModified.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/issues.html#create-a-to-do-item
+func (s *IssuesService) CreateTodo(pid interface{}, issue int, options ...RequestOptionFunc) (*Todo, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d/todo", pathEscape(project), issue)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(Todo)
+	resp, err := s.client.Do(req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+
+	return t, resp, err
+}
+
 // ListMergeRequestsClosingIssueOptions represents the available
 // ListMergeRequestsClosingIssue() options.
 //
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -76,6 +77,85 @@ func TestMoveIssue(t *testing.T) {
 	assert.Equal(t, want.ProjectID, issue.ProjectID)
 }
 
+func TestMoveIssueErrors(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/11/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Cannot move issue to project it originates from!"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/12/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Cannot move issue due to insufficient permissions!"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/13/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden - not allowed to create issues in the target project"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/issues/14/move", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message":"403 Forbidden"}`)
+	})
+
+	_, resp, err := client.Issues.MoveIssue("1", 11, &MoveIssueOptions{ToProjectID: Int(1)})
+	assert.True(t, errors.Is(err, ErrIssueTargetIsSource), "expected ErrIssueTargetIsSource, got %v", err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	_, _, err = client.Issues.MoveIssue("1", 12, &MoveIssueOptions{ToProjectID: Int(5)})
+	assert.True(t, errors.Is(err, ErrIssueTargetForbidden), "expected ErrIssueTargetForbidden, got %v", err)
+	var errResp *ErrorResponse
+	if assert.True(t, errors.As(err, &errResp)) {
+		assert.Contains(t, errResp.Message, "Cannot move issue due to insufficient permissions!")
+	}
+
+	_, _, err = client.Issues.MoveIssue("1", 13, &MoveIssueOptions{ToProjectID: Int(5)})
+	assert.True(t, errors.Is(err, ErrIssueTargetForbidden), "expected ErrIssueTargetForbidden, got %v", err)
+
+	_, _, err = client.Issues.MoveIssue("1", 14, &MoveIssueOptions{ToProjectID: Int(5)})
+	assert.False(t, errors.Is(err, ErrIssueTargetForbidden), "expected the original error, got %v", err)
+	_, ok := err.(*ErrorResponse)
+	assert.True(t, ok, "expected an *ErrorResponse, got %v", err)
+}
+
+func TestCloneIssue(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/11/clone", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"to_project_id":5,"with_notes":true}`)
+		fmt.Fprint(w, `{"id":93,"iid":1,"project_id":5}`)
+	})
+
+	issue, _, err := client.Issues.CloneIssue("1", 11, &CloneIssueOptions{ToProjectID: Int(5), WithNotes: Bool(true)})
+	if err != nil {
+		t.Fatalf("Issues.CloneIssue returned error: %v", err)
+	}
+
+	want := &Issue{ID: 93, IID: 1, ProjectID: 5}
+	assert.Equal(t, want, issue)
+}
+
+func TestCloneIssueUnknownError(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/11/clone", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Cannot clone issue to target project as it is pending deletion"}`)
+	})
+
+	_, _, err := client.Issues.CloneIssue("1", 11, &CloneIssueOptions{ToProjectID: Int(5)})
+	_, ok := err.(*ErrorResponse)
+	assert.True(t, ok, "expected an *ErrorResponse, got %v", err)
+}
+
 func TestListIssues(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -385,6 +465,69 @@ func TestUnsubscribeFromIssue(t *testing.T) {
 	}
 }
 
+func TestSubscribeToIssueNotModified(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	issue, resp, err := client.Issues.SubscribeToIssue("1", 5)
+	if err != nil {
+		t.Fatalf("Issues.SubscribeToIssue returned error: %v", err)
+	}
+	if issue != nil {
+		t.Errorf("Issues.SubscribeToIssue returned %+v, want nil", issue)
+	}
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Issues.SubscribeToIssue returned status %d, want %d", resp.StatusCode, http.StatusNotModified)
+	}
+}
+
+func TestCreateIssueTodo(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5/todo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 112, "action_name": "marked", "target_type": "Issue", "state": "pending"}`)
+	})
+
+	todo, _, err := client.Issues.CreateTodo("1", 5)
+	if err != nil {
+		t.Fatalf("Issues.CreateTodo returned error: %v", err)
+	}
+
+	want := &Todo{ID: 112, ActionName: TodoMarked, TargetType: "Issue", State: "pending"}
+	if !reflect.DeepEqual(want, todo) {
+		t.Errorf("Issues.CreateTodo returned %+v, want %+v", todo, want)
+	}
+}
+
+func TestCreateIssueTodoNotModified(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/issues/5/todo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	todo, resp, err := client.Issues.CreateTodo("1", 5)
+	if err != nil {
+		t.Fatalf("Issues.CreateTodo returned error: %v", err)
+	}
+	if todo != nil {
+		t.Errorf("Issues.CreateTodo returned %+v, want nil", todo)
+	}
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Issues.CreateTodo returned status %d, want %d", resp.StatusCode, http.StatusNotModified)
+	}
+}
+
 func TestListMergeRequestsClosingIssue(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -0,0 +1,111 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// IterationsService handles communication with the iterations related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/iterations.html
+type IterationsService struct {
+	client *Client
+}
+
+// Iteration represents a GitLab iteration.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/iterations.html
+type Iteration struct {
+	ID          int        `json:"id"`
+	IID         int        `json:"iid"`
+	Sequence    int        `json:"sequence"`
+	GroupID     int        `json:"group_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       int        `json:"state"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	DueDate     *ISOTime   `json:"due_date"`
+	StartDate   *ISOTime   `json:"start_date"`
+	WebURL      string     `json:"web_url"`
+}
+
+func (i Iteration) String() string {
+	return Stringify(i)
+}
+
+// ListGroupIterationsOptions represents the available ListGroupIterations()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_iterations.html#list-group-iterations
+type ListGroupIterationsOptions struct {
+	ListOptions
+	State            *string `url:"state,omitempty" json:"state,omitempty"`
+	Search           *string `url:"search,omitempty" json:"search,omitempty"`
+	IncludeAncestors *bool   `url:"include_ancestors,omitempty" json:"include_ancestors,omitempty"`
+}
+
+// ListGroupIterations returns a list of group iterations.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/group_iterations.html#list-group-iterations
+func (s *IterationsService) ListGroupIterations(gid interface{}, opt *ListGroupIterationsOptions, options ...RequestOptionFunc) ([]*Iteration, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/iterations", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var is []*Iteration
+	resp, err := s.client.Do(req, &is)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return is, resp, err
+}
+
+// ListProjectIterationsOptions represents the available
+// ListProjectIterations() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/iterations.html#list-project-iterations
+type ListProjectIterationsOptions struct {
+	ListOptions
+	State            *string `url:"state,omitempty" json:"state,omitempty"`
+	Search           *string `url:"search,omitempty" json:"search,omitempty"`
+	IncludeAncestors *bool   `url:"include_ancestors,omitempty" json:"include_ancestors,omitempty"`
+}
+
+// ListProjectIterations returns a list of the iterations of the ancestor
+// groups of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/iterations.html#list-project-iterations
+func (s *IterationsService) ListProjectIterations(pid interface{}, opt *ListProjectIterationsOptions, options ...RequestOptionFunc) ([]*Iteration, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/iterations", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var is []*Iteration
+	resp, err := s.client.Do(req, &is)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return is, resp, err
+}
@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testIteration = `{
+	"id": 53,
+	"iid": 13,
+	"sequence": 1,
+	"group_id": 5,
+	"title": "Iteration II",
+	"description": "Ipsum Lorem ipsum",
+	"state": 2,
+	"created_at": "2020-01-27T05:07:12.573Z",
+	"updated_at": "2020-01-27T05:07:12.573Z",
+	"due_date": "2020-02-01",
+	"start_date": "2020-02-14",
+	"web_url": "http://gitlab.example.com/groups/my-group/-/iterations/13"
+}`
+
+func wantIteration() *Iteration {
+	createdAt := time.Date(2020, time.January, 27, 5, 7, 12, 573000000, time.UTC)
+	dueDate := ISOTime(time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC))
+	startDate := ISOTime(time.Date(2020, time.February, 14, 0, 0, 0, 0, time.UTC))
+	return &Iteration{
+		ID:          53,
+		IID:         13,
+		Sequence:    1,
+		GroupID:     5,
+		Title:       "Iteration II",
+		Description: "Ipsum Lorem ipsum",
+		State:       2,
+		CreatedAt:   &createdAt,
+		UpdatedAt:   &createdAt,
+		DueDate:     &dueDate,
+		StartDate:   &startDate,
+		WebURL:      "http://gitlab.example.com/groups/my-group/-/iterations/13",
+	}
+}
+
+func TestListGroupIterations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/5/iterations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/5/iterations?include_ancestors=true&search=II&state=current")
+		fmt.Fprintf(w, "[%s]", testIteration)
+	})
+
+	opt := &ListGroupIterationsOptions{
+		State:            String("current"),
+		Search:           String("II"),
+		IncludeAncestors: Bool(true),
+	}
+	iterations, _, err := client.Iterations.ListGroupIterations(5, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*Iteration{wantIteration()}, iterations)
+}
+
+func TestListProjectIterations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/42/iterations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/42/iterations?state=opened")
+		fmt.Fprintf(w, "[%s]", testIteration)
+	})
+
+	opt := &ListProjectIterationsOptions{State: String("opened")}
+	iterations, _, err := client.Iterations.ListProjectIterations(42, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*Iteration{wantIteration()}, iterations)
+}
@@ -18,9 +18,23 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
+)
+
+// List a couple of standard errors.
+var (
+	ErrArtifactFileNotFound = errors.New("Artifact file does not exist")
+	ErrArtifactsNotFound    = errors.New("Artifacts do not exist")
+	ErrJobNotFound          = errors.New("Job does not exist")
+	ErrRefNotFound          = errors.New("Ref does not exist")
 )
 
 // JobsService handles communication with the ci builds related methods
@@ -201,24 +215,34 @@ type DownloadArtifactsFileOptions struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#download-the-artifacts-file
 func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt *DownloadArtifactsFileOptions, options ...RequestOptionFunc) (io.Reader, *Response, error) {
-	project, err := parseID(pid)
+	artifactsBuf := new(bytes.Buffer)
+	resp, err := s.DownloadArtifactsFileToWriter(pid, refName, opt, artifactsBuf, options...)
 	if err != nil {
-		return nil, nil, err
+		return nil, resp, err
 	}
-	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pathEscape(project), refName)
 
-	req, err := s.client.NewRequest("GET", u, opt, options)
+	return artifactsBuf, resp, err
+}
+
+// DownloadArtifactsFileToWriter download the artifacts file from the given
+// reference name and job provided the job finished successfully, and
+// streams it to w.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-the-artifacts-file
+func (s *JobsService) DownloadArtifactsFileToWriter(pid interface{}, refName string, opt *DownloadArtifactsFileOptions, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
+	u := fmt.Sprintf("projects/%s/jobs/artifacts/%s/download", pathEscape(project), refName)
 
-	artifactsBuf := new(bytes.Buffer)
-	resp, err := s.client.Do(req, artifactsBuf)
+	req, err := s.client.NewRequest("GET", u, opt, options)
 	if err != nil {
-		return nil, resp, err
+		return nil, err
 	}
 
-	return artifactsBuf, resp, err
+	return s.client.Do(req, w)
 }
 
 // DownloadSingleArtifactsFile download a file from the artifacts from the
@@ -229,9 +253,26 @@ func (s *JobsService) DownloadArtifactsFile(pid interface{}, refName string, opt
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#download-a-single-artifact-file
 func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, artifactPath string, options ...RequestOptionFunc) (io.Reader, *Response, error) {
+	artifactBuf := new(bytes.Buffer)
+	resp, err := s.DownloadSingleArtifactsFileToWriter(pid, jobID, artifactPath, artifactBuf, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return artifactBuf, resp, err
+}
+
+// DownloadSingleArtifactsFileToWriter download a single file from the
+// artifacts of the given job and streams it to w. If the job exists but the
+// file is not part of its artifacts ErrArtifactFileNotFound is returned, if
+// the job itself does not exist ErrJobNotFound is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-a-single-artifact-file
+func (s *JobsService) DownloadSingleArtifactsFileToWriter(pid interface{}, jobID int, artifactPath string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	u := fmt.Sprintf(
@@ -243,16 +284,79 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	artifactBuf := new(bytes.Buffer)
-	resp, err := s.client.Do(req, artifactBuf)
+	resp, err := s.client.Do(req, w)
 	if err != nil {
-		return nil, resp, err
+		// GitLab returns a 404 both when the job doesn't exist and when the
+		// file isn't part of the artifacts, so check if the job exists.
+		return resp, probeNotFound(err, func() (*Response, error) {
+			_, resp, err := s.GetJob(pid, jobID, options...)
+			return resp, err
+		}, ErrArtifactFileNotFound, ErrJobNotFound)
 	}
 
-	return artifactBuf, resp, err
+	return resp, err
+}
+
+// DownloadArtifactsByRefName downloads the artifacts archive of the latest
+// successful job with the given name for the given reference name, and
+// streams it to w. If GitLab redirects to external object storage, the
+// redirect is followed transparently.
+//
+// If the reference does not exist ErrRefNotFound is returned, if it exists
+// but no successful job with that name produced artifacts for it
+// ErrArtifactsNotFound is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-the-artifacts-archive
+func (s *JobsService) DownloadArtifactsByRefName(pid interface{}, refName, jobName string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	// DownloadArtifactsFileToWriter uses the reference name as given, so
+	// escape it here to support names containing a slash.
+	opt := &DownloadArtifactsFileOptions{Job: String(jobName)}
+	resp, err := s.DownloadArtifactsFileToWriter(pid, pathEscape(refName), opt, w, options...)
+	if err != nil {
+		return resp, s.client.Commits.refNotFound(pid, refName, ErrArtifactsNotFound, err, options)
+	}
+
+	return resp, err
+}
+
+// DownloadSingleArtifactByRefName downloads a single file from the artifacts
+// of the latest successful job with the given name for the given reference
+// name, and streams it to w.
+//
+// If the reference does not exist ErrRefNotFound is returned, if it exists
+// but no successful job with that name produced artifacts containing the
+// file ErrArtifactFileNotFound is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#download-a-single-artifact-file-from-specific-tag-or-branch
+func (s *JobsService) DownloadSingleArtifactByRefName(pid interface{}, refName, jobName, artifactPath string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/jobs/artifacts/%s/raw/%s",
+		pathEscape(project),
+		pathEscape(refName),
+		artifactPath,
+	)
+
+	opt := &DownloadArtifactsFileOptions{Job: String(jobName)}
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		return resp, s.client.Commits.refNotFound(pid, refName, ErrArtifactFileNotFound, err, options)
+	}
+
+	return resp, err
 }
 
 // GetTraceFile gets a trace of a specific job of a project
@@ -260,27 +364,112 @@ func (s *JobsService) DownloadSingleArtifactsFile(pid interface{}, jobID int, ar
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#get-a-trace-file
 func (s *JobsService) GetTraceFile(pid interface{}, jobID int, options ...RequestOptionFunc) (io.Reader, *Response, error) {
+	traceBuf := new(bytes.Buffer)
+	resp, err := s.GetTraceFileToWriter(pid, jobID, traceBuf, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return traceBuf, resp, err
+}
+
+// GetTraceFileToWriter gets a trace of a specific job of a project and
+// streams it to w. To tail the trace of a running job, pass the length of the
+// trace already received using WithTraceOffset. If no new content is
+// available yet nothing is written to w and no error is returned. When the
+// server ignores the offset and returns the complete trace, the part that was
+// already received is skipped. The length of the complete trace is returned
+// by TraceLength.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#get-a-trace-file
+func (s *JobsService) GetTraceFileToWriter(pid interface{}, jobID int, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/jobs/%d/trace", pathEscape(project), jobID)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	offset := traceOffset(req)
+	resp, err := s.client.Do(req, responseHandler(func(resp *http.Response) error {
+		if offset > 0 && resp.StatusCode == http.StatusOK {
+			// The server ignored the requested range and returned the
+			// complete trace, so skip the part that was already received.
+			if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+				if err == io.EOF {
+					return nil
+				}
+				return err
+			}
+		}
+		_, err := io.Copy(w, resp.Body)
+		return err
+	}))
+	if err != nil && resp != nil && resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+		// The requested offset is the end of the trace, so there is nothing
+		// new to return yet.
+		return resp, nil
+	}
+
+	return resp, err
+}
+
+// traceOffset returns the offset requested by WithTraceOffset, or 0 if no
+// offset was requested.
+func traceOffset(req *retryablehttp.Request) int64 {
+	r := req.Header.Get("Range")
+	if !strings.HasPrefix(r, "bytes=") || !strings.HasSuffix(r, "-") {
+		return 0
+	}
+	offset, err := strconv.ParseInt(r[len("bytes="):len(r)-1], 10, 64)
+	if err != nil || offset < 0 {
+		return 0
 	}
+	return offset
+}
 
-	traceBuf := new(bytes.Buffer)
-	resp, err := s.client.Do(req, traceBuf)
-	if err != nil {
-		return nil, resp, err
+// WithTraceOffset requests the trace of a job starting at the given byte
+// offset, using a Range header.
+func WithTraceOffset(offset int64) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		return nil
 	}
+}
 
-	return traceBuf, resp, err
+// TraceLength returns the length of the complete trace of a job as reported
+// by a response of GetTraceFile or GetTraceFileToWriter, which can be used as
+// offset for the next request. It returns -1 if the length is unknown.
+func TraceLength(resp *Response) int64 {
+	if resp == nil || resp.Response == nil {
+		return -1
+	}
+
+	// A ranged response reports the complete length as "bytes 10-19/20" or,
+	// when the range is not satisfiable, as "bytes */20".
+	if cr := resp.Header.Get("Content-Range"); cr != "" {
+		if i := strings.LastIndex(cr, "/"); i >= 0 {
+			if n, err := strconv.ParseInt(cr[i+1:], 10, 64); err == nil {
+				return n
+			}
+		}
+		return -1
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return resp.ContentLength
+	}
+
+	return -1
 }
 
-// CancelJob cancels a single job of a project.
+// CancelJob cancels a single job of a project. Canceling a job that already
+// finished is not an error, the job is returned unchanged.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#cancel-a-job
@@ -382,18 +571,45 @@ func (s *JobsService) KeepArtifacts(pid interface{}, jobID int, options ...Reque
 	return job, resp, err
 }
 
+// PlayJobOptions represents the available PlayJobWithOptions() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#play-a-job
+type PlayJobOptions struct {
+	JobVariablesAttributes []*JobVariableOptions `url:"job_variables_attributes,omitempty" json:"job_variables_attributes,omitempty"`
+}
+
+// JobVariableOptions represents a single variable passed to a manual job.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#play-a-job
+type JobVariableOptions struct {
+	Key          *string            `url:"key,omitempty" json:"key,omitempty"`
+	Value        *string            `url:"value,omitempty" json:"value,omitempty"`
+	VariableType *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
+}
+
 // PlayJob triggers a manual action to start a job.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/jobs.html#play-a-job
 func (s *JobsService) PlayJob(pid interface{}, jobID int, options ...RequestOptionFunc) (*Job, *Response, error) {
+	return s.PlayJobWithOptions(pid, jobID, nil, options...)
+}
+
+// PlayJobWithOptions triggers a manual action to start a job, passing the
+// given variables to it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/jobs.html#play-a-job
+func (s *JobsService) PlayJobWithOptions(pid interface{}, jobID int, opt *PlayJobOptions, options ...RequestOptionFunc) (*Job, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/jobs/%d/play", pathEscape(project), jobID)
 
-	req, err := s.client.NewRequest("POST", u, nil, options)
+	req, err := s.client.NewRequest("POST", u, opt, options)
 	if err != nil {
 		return nil, nil, err
 	}
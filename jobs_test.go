@@ -1,10 +1,15 @@
 package gitlab
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestListPipelineJobs(t *testing.T) {
@@ -26,3 +31,409 @@ func TestListPipelineJobs(t *testing.T) {
 		t.Errorf("Jobs.ListPipelineJobs returned %+v, want %+v", jobs, want)
 	}
 }
+
+func TestDownloadSingleArtifactsFileToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts/dist/app.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "content")
+	})
+
+	var b bytes.Buffer
+	_, err := client.Jobs.DownloadSingleArtifactsFileToWriter(1, 5, "dist/app.txt", &b)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactsFileToWriter returned error: %v", err)
+	}
+	if b.String() != "content" {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileToWriter wrote %q, want %q", b.String(), "content")
+	}
+}
+
+func TestDownloadSingleArtifactsFileToWriterNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Not Found"}`)
+	}
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/artifacts/missing.txt", notFound)
+	mux.HandleFunc("/api/v4/projects/1/jobs/6/artifacts/missing.txt", notFound)
+	mux.HandleFunc("/api/v4/projects/1/jobs/6", notFound)
+	mux.HandleFunc("/api/v4/projects/1/jobs/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 5}`)
+	})
+
+	var b bytes.Buffer
+	resp, err := client.Jobs.DownloadSingleArtifactsFileToWriter(1, 5, "missing.txt", &b)
+	if !errors.Is(err, ErrArtifactFileNotFound) {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileToWriter returned error %v, want %v", err, ErrArtifactFileNotFound)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileToWriter returned error %v, want it to wrap the 404 ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileToWriter returned response %+v, want status 404", resp)
+	}
+
+	_, err = client.Jobs.DownloadSingleArtifactsFileToWriter(1, 6, "missing.txt", &b)
+	if !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileToWriter returned error %v, want %v", err, ErrJobNotFound)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Jobs.DownloadSingleArtifactsFileToWriter wrote error body %q to writer", b.String())
+	}
+}
+
+func TestDownloadArtifactsFileToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/master/download?job=build")
+		fmt.Fprint(w, "zip")
+	})
+
+	var b bytes.Buffer
+	_, err := client.Jobs.DownloadArtifactsFileToWriter(1, "master", &DownloadArtifactsFileOptions{Job: String("build")}, &b)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadArtifactsFileToWriter returned error: %v", err)
+	}
+	if b.String() != "zip" {
+		t.Errorf("Jobs.DownloadArtifactsFileToWriter wrote %q, want %q", b.String(), "zip")
+	}
+}
+
+func TestDownloadArtifactsFileToWriterEscapedRef(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/feature/x/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/feature%2Fx/download?job=build")
+		fmt.Fprint(w, "zip")
+	})
+
+	// The reference name is used as given, so callers escaping it themselves
+	// keep working.
+	var b bytes.Buffer
+	_, err := client.Jobs.DownloadArtifactsFileToWriter(1, "feature%2Fx", &DownloadArtifactsFileOptions{Job: String("build")}, &b)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadArtifactsFileToWriter returned error: %v", err)
+	}
+}
+
+func TestDownloadArtifactsByRefName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/feature/x/download", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/feature%2Fx/download?job=build")
+		fmt.Fprint(w, "zip")
+	})
+
+	var b bytes.Buffer
+	_, err := client.Jobs.DownloadArtifactsByRefName(1, "feature/x", "build", &b)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadArtifactsByRefName returned error: %v", err)
+	}
+	if b.String() != "zip" {
+		t.Errorf("Jobs.DownloadArtifactsByRefName wrote %q, want %q", b.String(), "zip")
+	}
+}
+
+func TestDownloadArtifactsByRefNameRedirect(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get("PRIVATE-TOKEN"); token != "" {
+			t.Errorf("object storage received private token %q", token)
+		}
+		fmt.Fprint(w, "zip")
+	}))
+	defer storage.Close()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/download", func(w http.ResponseWriter, r *http.Request) {
+		if token := r.Header.Get("PRIVATE-TOKEN"); token != "secret" {
+			t.Errorf("GitLab received private token %q, want %q", token, "secret")
+		}
+		http.Redirect(w, r, storage.URL+"/artifacts.zip", http.StatusFound)
+	})
+
+	for name, httpClient := range map[string]ClientOptionFunc{
+		"default": nil,
+		"custom":  WithHTTPClient(&http.Client{}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, err := NewClient("secret", WithBaseURL(server.URL), httpClient)
+			if err != nil {
+				t.Fatalf("Failed to create client: %v", err)
+			}
+
+			var b bytes.Buffer
+			_, err = client.Jobs.DownloadArtifactsByRefName(1, "master", "build", &b)
+			if err != nil {
+				t.Fatalf("Jobs.DownloadArtifactsByRefName returned error: %v", err)
+			}
+			if b.String() != "zip" {
+				t.Errorf("Jobs.DownloadArtifactsByRefName wrote %q, want %q", b.String(), "zip")
+			}
+		})
+	}
+}
+
+func TestDownloadArtifactsByRefNameNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	notFound := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Not Found"}`)
+	}
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/download", notFound)
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/missing/download", notFound)
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/master/raw/dist/app.txt", notFound)
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/missing", notFound)
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/master", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "6104942438c14ec7bd21c6cd5bd995272b3faff6"}`)
+	})
+
+	var b bytes.Buffer
+	resp, err := client.Jobs.DownloadArtifactsByRefName(1, "master", "build", &b)
+	if !errors.Is(err, ErrArtifactsNotFound) {
+		t.Errorf("Jobs.DownloadArtifactsByRefName returned error %v, want %v", err, ErrArtifactsNotFound)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("Jobs.DownloadArtifactsByRefName returned error %v, want it to wrap the 404 ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Jobs.DownloadArtifactsByRefName returned response %+v, want status 404", resp)
+	}
+
+	_, err = client.Jobs.DownloadArtifactsByRefName(1, "missing", "build", &b)
+	if !errors.Is(err, ErrRefNotFound) {
+		t.Errorf("Jobs.DownloadArtifactsByRefName returned error %v, want %v", err, ErrRefNotFound)
+	}
+
+	_, err = client.Jobs.DownloadSingleArtifactByRefName(1, "master", "build", "dist/app.txt", &b)
+	if !errors.Is(err, ErrArtifactFileNotFound) {
+		t.Errorf("Jobs.DownloadSingleArtifactByRefName returned error %v, want %v", err, ErrArtifactFileNotFound)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Jobs.DownloadArtifactsByRefName wrote error body %q to writer", b.String())
+	}
+}
+
+func TestDownloadSingleArtifactByRefName(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/artifacts/v1.0.0/raw/dist/app.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/jobs/artifacts/v1%2E0%2E0/raw/dist/app.txt?job=build")
+		fmt.Fprint(w, "content")
+	})
+
+	var b bytes.Buffer
+	_, err := client.Jobs.DownloadSingleArtifactByRefName(1, "v1.0.0", "build", "dist/app.txt", &b)
+	if err != nil {
+		t.Fatalf("Jobs.DownloadSingleArtifactByRefName returned error: %v", err)
+	}
+	if b.String() != "content" {
+		t.Errorf("Jobs.DownloadSingleArtifactByRefName wrote %q, want %q", b.String(), "content")
+	}
+}
+
+func TestGetTraceFileToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	trace := "Running with gitlab-runner\nJob succeeded\n"
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		http.ServeContent(w, r, "trace", time.Time{}, strings.NewReader(trace))
+	})
+
+	var b bytes.Buffer
+	resp, err := client.Jobs.GetTraceFileToWriter(1, 5, &b)
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFileToWriter returned error: %v", err)
+	}
+	if b.String() != trace {
+		t.Errorf("Jobs.GetTraceFileToWriter wrote %q, want %q", b.String(), trace)
+	}
+	if got := TraceLength(resp); got != int64(len(trace)) {
+		t.Errorf("TraceLength returned %d, want %d", got, len(trace))
+	}
+
+	b.Reset()
+	resp, err = client.Jobs.GetTraceFileToWriter(1, 5, &b, WithTraceOffset(27))
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFileToWriter returned error: %v", err)
+	}
+	if b.String() != "Job succeeded\n" {
+		t.Errorf("Jobs.GetTraceFileToWriter wrote %q, want %q", b.String(), "Job succeeded\n")
+	}
+	if got := TraceLength(resp); got != int64(len(trace)) {
+		t.Errorf("TraceLength returned %d, want %d", got, len(trace))
+	}
+
+	b.Reset()
+	resp, err = client.Jobs.GetTraceFileToWriter(1, 5, &b, WithTraceOffset(int64(len(trace))))
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFileToWriter returned error: %v", err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Jobs.GetTraceFileToWriter wrote %q, want nothing", b.String())
+	}
+	if got := TraceLength(resp); got != int64(len(trace)) {
+		t.Errorf("TraceLength returned %d, want %d", got, len(trace))
+	}
+}
+
+func TestGetTraceFileToWriterRangeIgnored(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	trace := "Running with gitlab-runner\nJob succeeded\n"
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/trace", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, trace)
+	})
+
+	var b bytes.Buffer
+	_, err := client.Jobs.GetTraceFileToWriter(1, 5, &b, WithTraceOffset(27))
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFileToWriter returned error: %v", err)
+	}
+	if b.String() != "Job succeeded\n" {
+		t.Errorf("Jobs.GetTraceFileToWriter wrote %q, want %q", b.String(), "Job succeeded\n")
+	}
+
+	b.Reset()
+	_, err = client.Jobs.GetTraceFileToWriter(1, 5, &b, WithTraceOffset(int64(len(trace))))
+	if err != nil {
+		t.Fatalf("Jobs.GetTraceFileToWriter returned error: %v", err)
+	}
+	if b.Len() != 0 {
+		t.Errorf("Jobs.GetTraceFileToWriter wrote %q, want nothing", b.String())
+	}
+}
+
+func TestCancelFinishedJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/cancel", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":5,"status":"success"}`)
+	})
+
+	job, _, err := client.Jobs.CancelJob(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.CancelJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Status: "success"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.CancelJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestRetryJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/retry", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":6,"status":"pending"}`)
+	})
+
+	job, _, err := client.Jobs.RetryJob(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.RetryJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 6, Status: "pending"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.RetryJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestEraseJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/erase", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":5,"status":"failed"}`)
+	})
+
+	job, _, err := client.Jobs.EraseJob(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.EraseJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Status: "failed"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.EraseJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestPlayJob(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":5,"status":"pending"}`)
+	})
+
+	job, _, err := client.Jobs.PlayJob(1, 5)
+	if err != nil {
+		t.Fatalf("Jobs.PlayJob returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Status: "pending"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.PlayJob returned %+v, want %+v", job, want)
+	}
+}
+
+func TestPlayJobWithOptions(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/jobs/5/play", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"job_variables_attributes":[{"key":"DEPLOY_TARGET","value":"staging"},{"key":"CONFIG","value":"debug: true","variable_type":"file"}]}`)
+		fmt.Fprint(w, `{"id":5,"status":"pending"}`)
+	})
+
+	opt := &PlayJobOptions{
+		JobVariablesAttributes: []*JobVariableOptions{
+			{Key: String("DEPLOY_TARGET"), Value: String("staging")},
+			{Key: String("CONFIG"), Value: String("debug: true"), VariableType: VariableType(FileVariableType)},
+		},
+	}
+	job, _, err := client.Jobs.PlayJobWithOptions(1, 5, opt)
+	if err != nil {
+		t.Fatalf("Jobs.PlayJobWithOptions returned error: %v", err)
+	}
+
+	want := &Job{ID: 5, Status: "pending"}
+	if !reflect.DeepEqual(want, job) {
+		t.Errorf("Jobs.PlayJobWithOptions returned %+v, want %+v", job, want)
+	}
+}
@@ -40,6 +40,23 @@ type Key struct {
 	Key       string     `json:"key"`
 	CreatedAt *time.Time `json:"created_at"`
 	User      User       `json:"user"`
+
+	// DeployKeysProjects is only set when the key is a deploy key, which
+	// has no associated user.
+	DeployKeysProjects []*DeployKeysProject `json:"deploy_keys_projects"`
+}
+
+// DeployKeysProject represents a project a deploy key is enabled for.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/keys.html#get-user-by-fingerprint-of-ssh-key
+type DeployKeysProject struct {
+	ID          int        `json:"id"`
+	DeployKeyID int        `json:"deploy_key_id"`
+	ProjectID   int        `json:"project_id"`
+	CanPush     bool       `json:"can_push"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
 }
 
 // GetKeyWithUser gets a single key by id along with the associated
@@ -63,3 +80,32 @@ func (s *KeysService) GetKeyWithUser(key int, options ...RequestOptionFunc) (*Ke
 
 	return k, resp, err
 }
+
+// GetKeyByFingerprintOptions represents the available GetKeyByFingerprint()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/keys.html#get-user-by-fingerprint-of-ssh-key
+type GetKeyByFingerprintOptions struct {
+	Fingerprint string `url:"fingerprint" json:"fingerprint"`
+}
+
+// GetKeyByFingerprint gets a specific SSH key by its MD5 or SHA256
+// fingerprint, along with the associated user information.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/keys.html#get-user-by-fingerprint-of-ssh-key
+func (s *KeysService) GetKeyByFingerprint(opt *GetKeyByFingerprintOptions, options ...RequestOptionFunc) (*Key, *Response, error) {
+	req, err := s.client.NewRequest("GET", "keys", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(Key)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
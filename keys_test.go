@@ -84,3 +84,55 @@ func TestGetKeyWithUser(t *testing.T) {
 		t.Errorf("Keys.GetKeyWithUser returned %+v, want %+v", key, want)
 	}
 }
+
+func TestGetKeyByFingerprint(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/keys?fingerprint=SHA256%3AQj8gGAqRn2%2FVpSFAsfMEeF8uSsWuxOWtiQvLPnkPM74")
+		fmt.Fprint(w, `{"id":1,"title":"Sample key 1","user":{"id":25,"username":"john_smith"}}`)
+	})
+
+	opt := &GetKeyByFingerprintOptions{Fingerprint: "SHA256:Qj8gGAqRn2/VpSFAsfMEeF8uSsWuxOWtiQvLPnkPM74"}
+	key, _, err := client.Keys.GetKeyByFingerprint(opt)
+	if err != nil {
+		t.Fatalf("Keys.GetKeyByFingerprint returned error: %v", err)
+	}
+
+	want := &Key{ID: 1, Title: "Sample key 1", User: User{ID: 25, Username: "john_smith"}}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Keys.GetKeyByFingerprint returned %+v, want %+v", key, want)
+	}
+}
+
+func TestGetDeployKeyByFingerprint(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/keys", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/keys?fingerprint=ba%3A81%3A59%3A68%3Ad7%3A6c%3Acd%3A02%3A02%3Abf%3A6a%3A9b%3A55%3A4e%3Aaf%3Ad1")
+		fmt.Fprint(w, `{
+			"id": 1,
+			"title": "Sample deploy key",
+			"deploy_keys_projects": [{"id": 1, "deploy_key_id": 1, "project_id": 1, "can_push": false}]
+		}`)
+	})
+
+	opt := &GetKeyByFingerprintOptions{Fingerprint: "ba:81:59:68:d7:6c:cd:02:02:bf:6a:9b:55:4e:af:d1"}
+	key, _, err := client.Keys.GetKeyByFingerprint(opt)
+	if err != nil {
+		t.Fatalf("Keys.GetKeyByFingerprint returned error: %v", err)
+	}
+
+	want := &Key{
+		ID:                 1,
+		Title:              "Sample deploy key",
+		DeployKeysProjects: []*DeployKeysProject{{ID: 1, DeployKeyID: 1, ProjectID: 1}},
+	}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Keys.GetKeyByFingerprint returned %+v, want %+v", key, want)
+	}
+}
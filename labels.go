@@ -17,8 +17,10 @@
 package gitlab
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 )
 
 // LabelsService handles communication with the label related methods of the
@@ -281,25 +283,42 @@ func (s *LabelsService) UnsubscribeFromLabel(pid interface{}, labelID interface{
 	return s.client.Do(req, nil)
 }
 
-// PromoteLabel Promotes a project label to a group label.
+// PromoteLabel Promotes a project label to a group label and returns the
+// resulting group label. If the label can't be promoted, because it already
+// is a group label, an error is returned.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/labels.html#promote-a-project-label-to-a-group-label
-func (s *LabelsService) PromoteLabel(pid interface{}, labelID interface{}, options ...RequestOptionFunc) (*Response, error) {
+func (s *LabelsService) PromoteLabel(pid interface{}, labelID interface{}, options ...RequestOptionFunc) (*GroupLabel, *Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	label, err := parseID(labelID)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	u := fmt.Sprintf("projects/%s/labels/%s/promote", pathEscape(project), label)
 
 	req, err := s.client.NewRequest("PUT", u, nil, options)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return s.client.Do(req, nil)
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNotModified || b.Len() == 0 {
+		return nil, resp, fmt.Errorf("label %s of project %s is already a group label", label, project)
+	}
+
+	l := new(GroupLabel)
+	if err := json.Unmarshal(b.Bytes(), l); err != nil {
+		return nil, resp, err
+	}
+
+	return l, resp, nil
 }
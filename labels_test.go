@@ -163,3 +163,41 @@ func TestGetLabel(t *testing.T) {
 		t.Errorf("Labels.GetLabel returned %+v, want %+v", label, want)
 	}
 }
+
+func TestPromoteLabel(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels/5/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		fmt.Fprint(w, `{"id":5, "name": "My Label", "color" : "#11FF22"}`)
+	})
+
+	label, _, err := client.Labels.PromoteLabel("1", 5)
+	if err != nil {
+		t.Fatalf("Labels.PromoteLabel returned error: %v", err)
+	}
+
+	want := &GroupLabel{ID: 5, Name: "My Label", Color: "#11FF22"}
+	if !reflect.DeepEqual(want, label) {
+		t.Errorf("Labels.PromoteLabel returned %+v, want %+v", label, want)
+	}
+}
+
+func TestPromoteLabelNotModified(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/labels/5/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	label, resp, err := client.Labels.PromoteLabel("1", 5)
+	if err == nil {
+		t.Fatalf("Labels.PromoteLabel returned %+v, want an error", label)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotModified {
+		t.Errorf("Labels.PromoteLabel returned response %+v, want status 304", resp)
+	}
+}
@@ -0,0 +1,47 @@
+package gitlab
+
+// MarkdownService handles communication with the markdown related methods of
+// the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/markdown.html
+type MarkdownService struct {
+	client *Client
+}
+
+// Markdown represents a markdown document rendered as HTML.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/markdown.html
+type Markdown struct {
+	HTML string `json:"html"`
+}
+
+func (s Markdown) String() string {
+	return Stringify(s)
+}
+
+// RenderMarkdownOptions represents the available Render() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/markdown.html#render-an-arbitrary-markdown-document
+type RenderMarkdownOptions struct {
+	Text                    *string `url:"text,omitempty" json:"text,omitempty"`
+	GitlabFlavouredMarkdown *bool   `url:"gfm,omitempty" json:"gfm,omitempty"`
+	Project                 *string `url:"project,omitempty" json:"project,omitempty"`
+}
+
+// Render an arbitrary markdown document.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/markdown.html#render-an-arbitrary-markdown-document
+func (s *MarkdownService) Render(opt *RenderMarkdownOptions, options ...RequestOptionFunc) (*Markdown, *Response, error) {
+	req, err := s.client.NewRequest("POST", "markdown", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	md := new(Markdown)
+	resp, err := s.client.Do(req, md)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return md, resp, err
+}
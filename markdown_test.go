@@ -0,0 +1,33 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/markdown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"text":"# Testing","gfm":true,"project":"gitlab-org/gitlab"}`)
+		fmt.Fprint(w, `{"html": "<h1>Testing</h1>"}`)
+	})
+
+	opt := &RenderMarkdownOptions{
+		Text:                    String("# Testing"),
+		GitlabFlavouredMarkdown: Bool(true),
+		Project:                 String("gitlab-org/gitlab"),
+	}
+	md, _, err := client.Markdown.Render(opt)
+	if err != nil {
+		t.Fatalf("Markdown.Render returned error: %v", err)
+	}
+
+	want := "<h1>Testing</h1>"
+	if md.HTML != want {
+		t.Errorf("Markdown.Render returned %q, want %q", md.HTML, want)
+	}
+}
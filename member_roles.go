@@ -0,0 +1,184 @@
+package gitlab
+
+import "fmt"
+
+// MemberRolesService handles communication with the member roles related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/member_roles.html
+type MemberRolesService struct {
+	client *Client
+}
+
+// MemberRole represents a GitLab custom member role. A member role extends
+// the permissions of its base access level with the enabled permissions.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/member_roles.html
+type MemberRole struct {
+	ID                        int              `json:"id"`
+	Name                      string           `json:"name"`
+	Description               string           `json:"description"`
+	GroupID                   int              `json:"group_id"`
+	BaseAccessLevel           AccessLevelValue `json:"base_access_level"`
+	AdminCICDVariables        bool             `json:"admin_cicd_variables"`
+	AdminGroupMembers         bool             `json:"admin_group_member"`
+	AdminMergeRequests        bool             `json:"admin_merge_request"`
+	AdminPushRules            bool             `json:"admin_push_rules"`
+	AdminTerraformState       bool             `json:"admin_terraform_state"`
+	AdminVulnerability        bool             `json:"admin_vulnerability"`
+	ArchiveProject            bool             `json:"archive_project"`
+	ManageGroupAccessTokens   bool             `json:"manage_group_access_tokens"`
+	ManageProjectAccessTokens bool             `json:"manage_project_access_tokens"`
+	ReadCode                  bool             `json:"read_code"`
+	ReadDependency            bool             `json:"read_dependency"`
+	ReadVulnerability         bool             `json:"read_vulnerability"`
+	RemoveGroup               bool             `json:"remove_group"`
+	RemoveProject             bool             `json:"remove_project"`
+}
+
+// CreateMemberRoleOptions represents the available CreateMemberRole() and
+// CreateInstanceMemberRole() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#add-a-member-role-to-a-group
+type CreateMemberRoleOptions struct {
+	Name                      *string           `url:"name,omitempty" json:"name,omitempty"`
+	Description               *string           `url:"description,omitempty" json:"description,omitempty"`
+	BaseAccessLevel           *AccessLevelValue `url:"base_access_level,omitempty" json:"base_access_level,omitempty"`
+	AdminCICDVariables        *bool             `url:"admin_cicd_variables,omitempty" json:"admin_cicd_variables,omitempty"`
+	AdminGroupMembers         *bool             `url:"admin_group_member,omitempty" json:"admin_group_member,omitempty"`
+	AdminMergeRequests        *bool             `url:"admin_merge_request,omitempty" json:"admin_merge_request,omitempty"`
+	AdminPushRules            *bool             `url:"admin_push_rules,omitempty" json:"admin_push_rules,omitempty"`
+	AdminTerraformState       *bool             `url:"admin_terraform_state,omitempty" json:"admin_terraform_state,omitempty"`
+	AdminVulnerability        *bool             `url:"admin_vulnerability,omitempty" json:"admin_vulnerability,omitempty"`
+	ArchiveProject            *bool             `url:"archive_project,omitempty" json:"archive_project,omitempty"`
+	ManageGroupAccessTokens   *bool             `url:"manage_group_access_tokens,omitempty" json:"manage_group_access_tokens,omitempty"`
+	ManageProjectAccessTokens *bool             `url:"manage_project_access_tokens,omitempty" json:"manage_project_access_tokens,omitempty"`
+	ReadCode                  *bool             `url:"read_code,omitempty" json:"read_code,omitempty"`
+	ReadDependency            *bool             `url:"read_dependency,omitempty" json:"read_dependency,omitempty"`
+	ReadVulnerability         *bool             `url:"read_vulnerability,omitempty" json:"read_vulnerability,omitempty"`
+	RemoveGroup               *bool             `url:"remove_group,omitempty" json:"remove_group,omitempty"`
+	RemoveProject             *bool             `url:"remove_project,omitempty" json:"remove_project,omitempty"`
+}
+
+// ListInstanceMemberRoles gets all member roles of the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#get-all-instance-member-roles
+func (s *MemberRolesService) ListInstanceMemberRoles(options ...RequestOptionFunc) ([]*MemberRole, *Response, error) {
+	req, err := s.client.NewRequest("GET", "member_roles", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mrs []*MemberRole
+	resp, err := s.client.Do(req, &mrs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mrs, resp, err
+}
+
+// CreateInstanceMemberRole creates a new member role for the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#create-a-instance-member-role
+func (s *MemberRolesService) CreateInstanceMemberRole(opt *CreateMemberRoleOptions, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
+	req, err := s.client.NewRequest("POST", "member_roles", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mr := new(MemberRole)
+	resp, err := s.client.Do(req, mr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mr, resp, err
+}
+
+// DeleteInstanceMemberRole deletes a member role of the instance.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#delete-an-instance-member-role
+func (s *MemberRolesService) DeleteInstanceMemberRole(memberRole int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("member_roles/%d", memberRole)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListMemberRoles gets all member roles of a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#get-all-group-member-roles
+func (s *MemberRolesService) ListMemberRoles(gid interface{}, options ...RequestOptionFunc) ([]*MemberRole, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/member_roles", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mrs []*MemberRole
+	resp, err := s.client.Do(req, &mrs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mrs, resp, err
+}
+
+// CreateMemberRole creates a new member role for a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#add-a-member-role-to-a-group
+func (s *MemberRolesService) CreateMemberRole(gid interface{}, opt *CreateMemberRoleOptions, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/member_roles", pathEscape(group))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mr := new(MemberRole)
+	resp, err := s.client.Do(req, mr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mr, resp, err
+}
+
+// DeleteMemberRole deletes a member role of a top-level group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#remove-member-role-of-a-group
+func (s *MemberRolesService) DeleteMemberRole(gid interface{}, memberRole int, options ...RequestOptionFunc) (*Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("groups/%s/member_roles/%d", pathEscape(group), memberRole)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,115 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMemberRoles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/84/member_roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{
+			"id": 2,
+			"name": "Auditor",
+			"group_id": 84,
+			"base_access_level": 10,
+			"read_code": true,
+			"read_vulnerability": true
+		}]`)
+	})
+
+	roles, _, err := client.MemberRoles.ListMemberRoles(84)
+	require.NoError(t, err)
+
+	want := []*MemberRole{{
+		ID:                2,
+		Name:              "Auditor",
+		GroupID:           84,
+		BaseAccessLevel:   GuestPermissions,
+		ReadCode:          true,
+		ReadVulnerability: true,
+	}}
+	assert.Equal(t, want, roles)
+}
+
+func TestCreateMemberRole(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/84/member_roles", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"Auditor","base_access_level":10,"read_code":true,"read_vulnerability":true}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 3, "name": "Auditor", "group_id": 84, "base_access_level": 10, "read_code": true, "read_vulnerability": true}`)
+	})
+
+	opt := &CreateMemberRoleOptions{
+		Name:              String("Auditor"),
+		BaseAccessLevel:   AccessLevel(GuestPermissions),
+		ReadCode:          Bool(true),
+		ReadVulnerability: Bool(true),
+	}
+	role, _, err := client.MemberRoles.CreateMemberRole(84, opt)
+	require.NoError(t, err)
+
+	want := &MemberRole{ID: 3, Name: "Auditor", GroupID: 84, BaseAccessLevel: GuestPermissions, ReadCode: true, ReadVulnerability: true}
+	assert.Equal(t, want, role)
+}
+
+func TestDeleteMemberRole(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/84/member_roles/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.MemberRoles.DeleteMemberRole(84, 3)
+	require.NoError(t, err)
+}
+
+func TestInstanceMemberRoles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/member_roles", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id": 4, "name": "Maintainer+", "base_access_level": 40}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"name":"Maintainer+","base_access_level":40,"admin_cicd_variables":true}`)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"id": 4, "name": "Maintainer+", "base_access_level": 40, "admin_cicd_variables": true}`)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/api/v4/member_roles/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opt := &CreateMemberRoleOptions{
+		Name:               String("Maintainer+"),
+		BaseAccessLevel:    AccessLevel(MaintainerPermissions),
+		AdminCICDVariables: Bool(true),
+	}
+	role, _, err := client.MemberRoles.CreateInstanceMemberRole(opt)
+	require.NoError(t, err)
+	assert.Equal(t, &MemberRole{ID: 4, Name: "Maintainer+", BaseAccessLevel: MaintainerPermissions, AdminCICDVariables: true}, role)
+
+	roles, _, err := client.MemberRoles.ListInstanceMemberRoles()
+	require.NoError(t, err)
+	assert.Equal(t, []*MemberRole{{ID: 4, Name: "Maintainer+", BaseAccessLevel: MaintainerPermissions}}, roles)
+
+	_, err = client.MemberRoles.DeleteInstanceMemberRole(4)
+	require.NoError(t, err)
+}
@@ -1,10 +1,23 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 )
 
+var (
+	// ErrApprovalNotAllowed is returned when the authenticated user is not
+	// allowed to approve or unapprove the merge request. Both errors wrap the
+	// ErrorResponse of GitLab, so check for them using errors.Is.
+	ErrApprovalNotAllowed = errors.New("Not allowed to approve or unapprove the merge request")
+
+	// ErrApprovalSHAMismatch is returned by ApproveMergeRequest when the SHA
+	// option does not match the HEAD of the merge request.
+	ErrApprovalSHAMismatch = errors.New("SHA does not match the HEAD of the merge request")
+)
+
 // MergeRequestApprovalsService handles communication with the merge request
 // approvals related methods of the GitLab API. This includes reading/updating
 // approval settings and approve/unapproving merge requests
@@ -68,6 +81,7 @@ type MergeRequestApprovalRule struct {
 	ID                   int                  `json:"id"`
 	Name                 string               `json:"name"`
 	RuleType             string               `json:"rule_type"`
+	ReportType           string               `json:"report_type"`
 	EligibleApprovers    []*BasicUser         `json:"eligible_approvers"`
 	ApprovalsRequired    int                  `json:"approvals_required"`
 	SourceRule           *ProjectApprovalRule `json:"source_rule"`
@@ -109,7 +123,9 @@ type ApproveMergeRequestOptions struct {
 }
 
 // ApproveMergeRequest approves a merge request on GitLab. If a non-empty sha
-// is provided then it must match the sha at the HEAD of the MR.
+// is provided then it must match the sha at the HEAD of the MR, otherwise
+// ErrApprovalSHAMismatch is returned. ErrApprovalNotAllowed is returned when
+// the user is not allowed to approve the merge request.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#approve-merge-request
@@ -128,13 +144,16 @@ func (s *MergeRequestApprovalsService) ApproveMergeRequest(pid interface{}, mr i
 	m := new(MergeRequestApprovals)
 	resp, err := s.client.Do(req, m)
 	if err != nil {
-		return nil, resp, err
+		err = wrapErrorResponse(err, http.StatusUnauthorized, "", ErrApprovalNotAllowed)
+		return nil, resp, wrapErrorResponse(err, http.StatusConflict, "", ErrApprovalSHAMismatch)
 	}
 
 	return m, resp, err
 }
 
-// UnapproveMergeRequest unapproves a previously approved merge request on GitLab.
+// UnapproveMergeRequest unapproves a previously approved merge request on
+// GitLab. ErrApprovalNotAllowed is returned when the user is not allowed to
+// unapprove the merge request.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/merge_request_approvals.html#unapprove-merge-request
@@ -150,7 +169,12 @@ func (s *MergeRequestApprovalsService) UnapproveMergeRequest(pid interface{}, mr
 		return nil, err
 	}
 
-	return s.client.Do(req, nil)
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, wrapErrorResponse(err, http.StatusUnauthorized, "", ErrApprovalNotAllowed)
+	}
+
+	return resp, err
 }
 
 // ChangeMergeRequestApprovalConfigurationOptions represents the available
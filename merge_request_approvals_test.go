@@ -1,9 +1,11 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -415,3 +417,127 @@ func TestCreateApprovalRules(t *testing.T) {
 		t.Errorf("MergeRequestApprovals.CreateApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestUpdateApprovalRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approval_rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"name":"License-Check","approvals_required":1,"user_ids":[5]}`)
+		fmt.Fprint(w, `{
+			"id": 2,
+			"name": "License-Check",
+			"rule_type": "report_approver",
+			"report_type": "license_scanning",
+			"eligible_approvers": [{"id": 5, "username": "jdoe"}],
+			"approvals_required": 1
+		}`)
+	})
+
+	opt := &UpdateMergeRequestApprovalRuleOptions{
+		Name:              String("License-Check"),
+		ApprovalsRequired: Int(1),
+		UserIDs:           []int{5},
+	}
+
+	rule, _, err := client.MergeRequestApprovals.UpdateApprovalRule(1, 1, 2, opt)
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.UpdateApprovalRule returned error: %v", err)
+	}
+
+	want := &MergeRequestApprovalRule{
+		ID:                2,
+		Name:              "License-Check",
+		RuleType:          "report_approver",
+		ReportType:        "license_scanning",
+		EligibleApprovers: []*BasicUser{{ID: 5, Username: "jdoe"}},
+		ApprovalsRequired: 1,
+	}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("MergeRequestApprovals.UpdateApprovalRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestDeleteApprovalRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approval_rules/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.MergeRequestApprovals.DeleteApprovalRule(1, 1, 2)
+	if err != nil {
+		t.Errorf("MergeRequestApprovals.DeleteApprovalRule returned error: %v", err)
+	}
+}
+
+func TestApproveMergeRequest(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approve", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"sha":"abc123"}`)
+		fmt.Fprint(w, `{"id": 5, "iid": 1, "project_id": 1, "approved": true, "approved_by": [{"user": {"id": 1, "username": "root"}}]}`)
+	})
+
+	approvals, _, err := client.MergeRequestApprovals.ApproveMergeRequest(1, 1, &ApproveMergeRequestOptions{SHA: String("abc123")})
+	if err != nil {
+		t.Fatalf("MergeRequestApprovals.ApproveMergeRequest returned error: %v", err)
+	}
+
+	want := []*MergeRequestApproverUser{{User: &BasicUser{ID: 1, Username: "root"}}}
+	if !reflect.DeepEqual(want, approvals.ApprovedBy) {
+		t.Errorf("MergeRequestApprovals.ApproveMergeRequest returned approved_by %+v, want %+v", approvals.ApprovedBy, want)
+	}
+}
+
+func TestApproveMergeRequestErrors(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/approve", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"SHA does not match HEAD of source branch: def456"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/2/approve", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"401 Unauthorized"}`)
+	})
+
+	_, resp, err := client.MergeRequestApprovals.ApproveMergeRequest(1, 1, &ApproveMergeRequestOptions{SHA: String("abc123")})
+	if !errors.Is(err, ErrApprovalSHAMismatch) {
+		t.Errorf("MergeRequestApprovals.ApproveMergeRequest returned error %v, want %v", err, ErrApprovalSHAMismatch)
+	}
+	if resp == nil || resp.StatusCode != http.StatusConflict {
+		t.Errorf("MergeRequestApprovals.ApproveMergeRequest returned response %+v, want status 409", resp)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || !strings.Contains(errResp.Message, "def456") {
+		t.Errorf("MergeRequestApprovals.ApproveMergeRequest returned error %v, want it to wrap the ErrorResponse", err)
+	}
+
+	_, _, err = client.MergeRequestApprovals.ApproveMergeRequest(1, 2, nil)
+	if !errors.Is(err, ErrApprovalNotAllowed) {
+		t.Errorf("MergeRequestApprovals.ApproveMergeRequest returned error %v, want %v", err, ErrApprovalNotAllowed)
+	}
+}
+
+func TestUnapproveMergeRequestNotAllowed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/unapprove", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message":"401 Unauthorized"}`)
+	})
+
+	_, err := client.MergeRequestApprovals.UnapproveMergeRequest(1, 1)
+	if !errors.Is(err, ErrApprovalNotAllowed) {
+		t.Errorf("MergeRequestApprovals.UnapproveMergeRequest returned error %v, want %v", err, ErrApprovalNotAllowed)
+	}
+}
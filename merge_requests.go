@@ -17,10 +17,19 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"time"
 )
 
+// ErrNoMergeRequestPipelineConfig is returned by CreateMergeRequestPipeline
+// when the CI configuration of the project does not define any jobs for
+// merge request pipelines. The ErrorResponse with the message of GitLab is
+// wrapped, so check for it using errors.Is.
+var ErrNoMergeRequestPipelineConfig = errors.New("No CI configuration for merge request pipelines")
+
 // MergeRequestsService handles communication with the merge requests related
 // methods of the GitLab API.
 //
@@ -376,6 +385,10 @@ func (s *MergeRequestsService) GetMergeRequestCommits(pid interface{}, mergeRequ
 // GetMergeRequestChanges shows information about the merge request including
 // its files and changes.
 //
+// Deprecated: This endpoint returns all diffs at once and is deprecated by
+// GitLab, use ListMergeRequestDiffs instead. The diff references needed to
+// create inline comments are also returned by GetMergeRequest.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/merge_requests.html#get-single-mr-changes
 func (s *MergeRequestsService) GetMergeRequestChanges(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
@@ -399,6 +412,62 @@ func (s *MergeRequestsService) GetMergeRequestChanges(pid interface{}, mergeRequ
 	return m, resp, err
 }
 
+// MergeRequestDiff represents a single diff of a merge request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
+type MergeRequestDiff struct {
+	OldPath       string `json:"old_path"`
+	NewPath       string `json:"new_path"`
+	AMode         string `json:"a_mode"`
+	BMode         string `json:"b_mode"`
+	Diff          string `json:"diff"`
+	NewFile       bool   `json:"new_file"`
+	RenamedFile   bool   `json:"renamed_file"`
+	DeletedFile   bool   `json:"deleted_file"`
+	GeneratedFile bool   `json:"generated_file"`
+}
+
+func (d MergeRequestDiff) String() string {
+	return Stringify(d)
+}
+
+// ListMergeRequestDiffsOptions represents the available ListMergeRequestDiffs()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
+type ListMergeRequestDiffsOptions struct {
+	ListOptions
+	Unidiff *bool `url:"unidiff,omitempty" json:"unidiff,omitempty"`
+}
+
+// ListMergeRequestDiffs lists the diffs of the files changed in a merge
+// request, one page at a time.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_requests.html#list-merge-request-diffs
+func (s *MergeRequestsService) ListMergeRequestDiffs(pid interface{}, mergeRequest int, opt *ListMergeRequestDiffsOptions, options ...RequestOptionFunc) ([]*MergeRequestDiff, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/diffs", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var d []*MergeRequestDiff
+	resp, err := s.client.Do(req, &d)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return d, resp, err
+}
+
 // GetMergeRequestParticipants gets a list of merge request participants.
 //
 // GitLab API docs:
@@ -449,6 +518,34 @@ func (s *MergeRequestsService) ListMergeRequestPipelines(pid interface{}, mergeR
 	return p, resp, err
 }
 
+// CreateMergeRequestPipeline creates a new pipeline for the provided merge
+// request. Depending on the project settings this is a detached merge request
+// pipeline or a merged results pipeline. ErrNoMergeRequestPipelineConfig is
+// returned when there is nothing to run for merge request pipelines.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/merge_requests.html#create-mr-pipeline
+func (s *MergeRequestsService) CreateMergeRequestPipeline(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*PipelineInfo, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_requests/%d/pipelines", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(PipelineInfo)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusBadRequest, "No stages / jobs", ErrNoMergeRequestPipelineConfig)
+	}
+
+	return p, resp, err
+}
+
 // GetIssuesClosedOnMergeOptions represents the available GetIssuesClosedOnMerge()
 // options.
 //
@@ -662,20 +759,32 @@ func (s *MergeRequestsService) CancelMergeWhenPipelineSucceeds(pid interface{},
 	return m, resp, err
 }
 
+// RebaseMergeRequestOptions represents the available RebaseMergeRequest()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/merge_requests.html#rebase-a-merge-request
+type RebaseMergeRequestOptions struct {
+	SkipCI *bool `url:"skip_ci,omitempty" json:"skip_ci,omitempty"`
+}
+
 // RebaseMergeRequest automatically rebases the source_branch of the merge
 // request against its target_branch. If you don’t have permissions to push
 // to the merge request’s source branch, you’ll get a 403 Forbidden response.
 //
+// The rebase is performed asynchronously. Use WaitForRebase, or poll
+// GetMergeRequest with IncludeRebaseInProgress set, to wait for it to finish.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/merge_requests.html#rebase-a-merge-request
-func (s *MergeRequestsService) RebaseMergeRequest(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*Response, error) {
+func (s *MergeRequestsService) RebaseMergeRequest(pid interface{}, mergeRequest int, opt *RebaseMergeRequestOptions, options ...RequestOptionFunc) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, err
 	}
 	u := fmt.Sprintf("projects/%s/merge_requests/%d/rebase", pathEscape(project), mergeRequest)
 
-	req, err := s.client.NewRequest("PUT", u, nil, options)
+	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
 		return nil, err
 	}
@@ -683,6 +792,36 @@ func (s *MergeRequestsService) RebaseMergeRequest(pid interface{}, mergeRequest
 	return s.client.Do(req, nil)
 }
 
+// WaitForRebase polls the given merge request with the given interval until
+// it is no longer being rebased, and returns the merge request. If the rebase
+// failed, the merge error reported by GitLab (for example because of
+// conflicts) is returned as an error. Polling stops when ctx is done.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/merge_requests.html#rebase-a-merge-request
+func (s *MergeRequestsService) WaitForRebase(ctx context.Context, pid interface{}, mergeRequest int, interval time.Duration, options ...RequestOptionFunc) (*MergeRequest, *Response, error) {
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+	opt := &GetMergeRequestsOptions{IncludeRebaseInProgress: Bool(true)}
+
+	for {
+		m, resp, err := s.GetMergeRequest(pid, mergeRequest, opt, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if !m.RebaseInProgress {
+			if m.MergeError != "" {
+				return m, resp, fmt.Errorf("rebase of merge request !%d failed: %s", m.IID, m.MergeError)
+			}
+			return m, resp, nil
+		}
+
+		if err := waitForInterval(ctx, interval); err != nil {
+			return m, resp, err
+		}
+	}
+}
+
 // GetMergeRequestDiffVersionsOptions represents the available
 // GetMergeRequestDiffVersions() options.
 //
@@ -742,7 +881,8 @@ func (s *MergeRequestsService) GetSingleMergeRequestDiffVersion(pid interface{},
 
 // SubscribeToMergeRequest subscribes the authenticated user to the given merge
 // request to receive notifications. If the user is already subscribed to the
-// merge request, the status code 304 is returned.
+// merge request, no error and a nil merge request are returned, and the status
+// code of the response is http.StatusNotModified.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/merge_requests.html#subscribe-to-a-merge-request
@@ -764,13 +904,18 @@ func (s *MergeRequestsService) SubscribeToMergeRequest(pid interface{}, mergeReq
 		return nil, resp, err
 	}
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+
 	return m, resp, err
 }
 
 // UnsubscribeFromMergeRequest unsubscribes the authenticated user from the
 // given merge request to not receive notifications from that merge request.
-// If the user is not subscribed to the merge request, status code 304 is
-// returned.
+// If the user is not subscribed to the merge request, no error and a nil merge
+// request are returned, and the status code of the response is
+// http.StatusNotModified.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/merge_requests.html#unsubscribe-from-a-merge-request
@@ -792,12 +937,17 @@ func (s *MergeRequestsService) UnsubscribeFromMergeRequest(pid interface{}, merg
 		return nil, resp, err
 	}
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+
 	return m, resp, err
 }
 
 // CreateTodo manually creates a todo for the current user on a merge request.
-// If there already exists a todo for the user on that merge request,
-// status code 304 is returned.
+// If there already exists a todo for the user on that merge request, no error
+// and a nil todo are returned, and the status code of the response is
+// http.StatusNotModified.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/merge_requests.html#create-a-todo
@@ -819,6 +969,10 @@ func (s *MergeRequestsService) CreateTodo(pid interface{}, mergeRequest int, opt
 		return nil, resp, err
 	}
 
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, resp, nil
+	}
+
 	return t, resp, err
 }
 
@@ -1,6 +1,9 @@
 package gitlab
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
@@ -159,3 +162,283 @@ func TestListProjectMergeRequests(t *testing.T) {
 		assert.Equal(t, "", mr.DiffRefs.HeadSha)
 	}
 }
+
+func TestUnsubscribeFromMergeRequestNotModified(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	mr, resp, err := client.MergeRequests.UnsubscribeFromMergeRequest(1, 5)
+	require.NoError(t, err)
+	assert.Nil(t, mr)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+}
+
+func TestCreateMergeRequestTodo(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/todo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 102, "action_name": "marked", "target_type": "MergeRequest", "state": "pending"}`))
+	})
+
+	todo, _, err := client.MergeRequests.CreateTodo(1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, &Todo{ID: 102, ActionName: TodoMarked, TargetType: "MergeRequest", State: "pending"}, todo)
+}
+
+func TestRebaseMergeRequest(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/rebase", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"skip_ci":true}`)
+		w.WriteHeader(http.StatusAccepted)
+		w.Write([]byte(`{"rebase_in_progress": true}`))
+	})
+
+	resp, err := client.MergeRequests.RebaseMergeRequest(1, 5, &RebaseMergeRequestOptions{SkipCI: Bool(true)})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestWaitForRebase(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	polls := 0
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/merge_requests/5?include_rebase_in_progress=true")
+		polls++
+		if polls < 3 {
+			w.Write([]byte(`{"iid": 5, "rebase_in_progress": true}`))
+			return
+		}
+		w.Write([]byte(`{"iid": 5, "rebase_in_progress": false}`))
+	})
+
+	mr, _, err := client.MergeRequests.WaitForRebase(context.Background(), 1, 5, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, 5, mr.IID)
+	assert.Equal(t, 3, polls)
+}
+
+func TestWaitForRebaseFailed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"iid": 5, "rebase_in_progress": false, "merge_error": "Rebase failed: Rebase locally, resolve all conflicts, then push the branch."}`))
+	})
+
+	mr, _, err := client.MergeRequests.WaitForRebase(context.Background(), 1, 5, time.Millisecond)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Rebase locally, resolve all conflicts")
+	assert.Equal(t, "Rebase failed: Rebase locally, resolve all conflicts, then push the branch.", mr.MergeError)
+}
+
+func TestGetIssuesClosedOnMerge(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/closes_issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/merge_requests/5/closes_issues?page=1&per_page=20")
+		fmt.Fprint(w, `[{"id":76,"iid":6,"project_id":1,"state":"opened"}]`)
+	})
+
+	opt := &GetIssuesClosedOnMergeOptions{Page: 1, PerPage: 20}
+	issues, _, err := client.MergeRequests.GetIssuesClosedOnMerge(1, 5, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*Issue{{ID: 76, IID: 6, ProjectID: 1, State: "opened"}}, issues)
+}
+
+func TestMergeRequestSetTimeEstimate(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/time_estimate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"duration":"3h30m"}`)
+		fmt.Fprint(w, `{"human_time_estimate": "3h 30m", "human_total_time_spent": null, "time_estimate": 12600, "total_time_spent": 0}`)
+	})
+
+	stats, _, err := client.MergeRequests.SetTimeEstimate(1, 5, &SetTimeEstimateOptions{Duration: String("3h30m")})
+	require.NoError(t, err)
+	assert.Equal(t, &TimeStats{HumanTimeEstimate: "3h 30m", TimeEstimate: 12600}, stats)
+}
+
+func TestMergeRequestAddSpentTime(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/add_spent_time", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"duration":"1h","summary":"code review"}`)
+		fmt.Fprint(w, `{"human_time_estimate": null, "human_total_time_spent": "1h", "time_estimate": 0, "total_time_spent": 3600}`)
+	})
+
+	opt := &AddSpentTimeOptions{Duration: String("1h"), Summary: String("code review")}
+	stats, _, err := client.MergeRequests.AddSpentTime(1, 5, opt)
+	require.NoError(t, err)
+	assert.Equal(t, &TimeStats{HumanTotalTimeSpent: "1h", TotalTimeSpent: 3600}, stats)
+}
+
+func TestMergeRequestResetTimeTracking(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	for _, path := range []string{"reset_time_estimate", "reset_spent_time"} {
+		mux.HandleFunc("/api/v4/projects/1/merge_requests/5/"+path, func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			fmt.Fprint(w, `{"human_time_estimate": null, "human_total_time_spent": null, "time_estimate": 0, "total_time_spent": 0}`)
+		})
+	}
+
+	stats, _, err := client.MergeRequests.ResetTimeEstimate(1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, &TimeStats{}, stats)
+
+	stats, _, err = client.MergeRequests.ResetSpentTime(1, 5)
+	require.NoError(t, err)
+	assert.Equal(t, &TimeStats{}, stats)
+}
+
+func TestMergeRequestGetTimeSpent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/5/time_stats", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"human_time_estimate": "2h", "human_total_time_spent": "1h", "time_estimate": 7200, "total_time_spent": 3600}`)
+	})
+
+	stats, _, err := client.MergeRequests.GetTimeSpent(1, 5)
+	require.NoError(t, err)
+	want := &TimeStats{HumanTimeEstimate: "2h", HumanTotalTimeSpent: "1h", TimeEstimate: 7200, TotalTimeSpent: 3600}
+	assert.Equal(t, want, stats)
+}
+
+func TestListMergeRequestDiffs(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/diffs", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/merge_requests/1/diffs?page=2&per_page=1&unidiff=true")
+		fmt.Fprint(w, `[{
+			"old_path": "README",
+			"new_path": "README",
+			"a_mode": "100644",
+			"b_mode": "100644",
+			"diff": "@@ -1 +1 @@\n-Title\n+README",
+			"new_file": false,
+			"renamed_file": false,
+			"deleted_file": false,
+			"generated_file": false
+		}]`)
+	})
+
+	opt := &ListMergeRequestDiffsOptions{
+		ListOptions: ListOptions{Page: 2, PerPage: 1},
+		Unidiff:     Bool(true),
+	}
+	diffs, _, err := client.MergeRequests.ListMergeRequestDiffs(1, 1, opt)
+	require.NoError(t, err)
+
+	want := []*MergeRequestDiff{{
+		OldPath: "README",
+		NewPath: "README",
+		AMode:   "100644",
+		BMode:   "100644",
+		Diff:    "@@ -1 +1 @@\n-Title\n+README",
+	}}
+	assert.Equal(t, want, diffs)
+}
+
+func TestListMergeRequestPipelines(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":77,"sha":"959e04d7c7a30600c894bd3c0cd0e1ce7f42c11d","ref":"main","status":"success","source":"merge_request_event"}]`)
+	})
+
+	pipelines, _, err := client.MergeRequests.ListMergeRequestPipelines(1, 1)
+	require.NoError(t, err)
+
+	want := []*PipelineInfo{{
+		ID:     77,
+		SHA:    "959e04d7c7a30600c894bd3c0cd0e1ce7f42c11d",
+		Ref:    "main",
+		Status: "success",
+		Source: "merge_request_event",
+	}}
+	assert.Equal(t, want, pipelines)
+}
+
+func TestCreateMergeRequestPipeline(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id":78,"ref":"refs/merge-requests/1/head","status":"pending","source":"merge_request_event"}`)
+	})
+
+	pipeline, _, err := client.MergeRequests.CreateMergeRequestPipeline(1, 1)
+	require.NoError(t, err)
+
+	want := &PipelineInfo{
+		ID:     78,
+		Ref:    "refs/merge-requests/1/head",
+		Status: "pending",
+		Source: "merge_request_event",
+	}
+	assert.Equal(t, want, pipeline)
+}
+
+func TestCreateMergeRequestPipelineNoConfig(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":{"base":["No stages / jobs for this pipeline."]}}`)
+	})
+
+	pipeline, resp, err := client.MergeRequests.CreateMergeRequestPipeline(1, 1)
+	assert.True(t, errors.Is(err, ErrNoMergeRequestPipelineConfig), "expected ErrNoMergeRequestPipelineConfig, got %v", err)
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	assert.Contains(t, errResp.Message, "No stages / jobs for this pipeline.")
+	assert.Nil(t, pipeline)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestCreateMergeRequestPipelineInvalidConfig(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_requests/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":{"base":["jobs:test config contains unknown keys: scripts"]}}`)
+	})
+
+	_, _, err := client.MergeRequests.CreateMergeRequestPipeline(1, 1)
+	assert.False(t, errors.Is(err, ErrNoMergeRequestPipelineConfig), "expected the original error, got %v", err)
+	_, ok := err.(*ErrorResponse)
+	assert.True(t, ok, "expected an *ErrorResponse, got %v", err)
+}
@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// MergeTrainsService handles communication with the merge trains related
+// methods of the GitLab API.
+//
+// GitLab doesn't provide a dedicated endpoint to remove a merge request from
+// a merge train. A merge request is removed from its train by cancelling the
+// automatic merge, see MergeRequestsService.CancelMergeWhenPipelineSucceeds.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/merge_trains.html
+type MergeTrainsService struct {
+	client *Client
+}
+
+// MergeTrainStatusValue represents the status of a merge request in a merge
+// train.
+type MergeTrainStatusValue string
+
+// These constants represent all valid merge train statuses.
+const (
+	MergeTrainIdle       MergeTrainStatusValue = "idle"
+	MergeTrainStale      MergeTrainStatusValue = "stale"
+	MergeTrainFresh      MergeTrainStatusValue = "fresh"
+	MergeTrainMerging    MergeTrainStatusValue = "merging"
+	MergeTrainMerged     MergeTrainStatusValue = "merged"
+	MergeTrainSkipMerged MergeTrainStatusValue = "skip_merged"
+)
+
+// MergeTrain represents a merge request in a merge train. Merge requests
+// are returned in the order of their position in the train.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/merge_trains.html
+type MergeTrain struct {
+	ID           int                     `json:"id"`
+	MergeRequest *MergeTrainMergeRequest `json:"merge_request"`
+	User         *BasicUser              `json:"user"`
+	Pipeline     *PipelineInfo           `json:"pipeline"`
+	CreatedAt    *time.Time              `json:"created_at"`
+	UpdatedAt    *time.Time              `json:"updated_at"`
+	TargetBranch string                  `json:"target_branch"`
+	Status       MergeTrainStatusValue   `json:"status"`
+	MergedAt     *time.Time              `json:"merged_at"`
+	Duration     int                     `json:"duration"`
+}
+
+func (m MergeTrain) String() string {
+	return Stringify(m)
+}
+
+// MergeTrainMergeRequest represents the merge request of a merge train.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/merge_trains.html
+type MergeTrainMergeRequest struct {
+	ID          int        `json:"id"`
+	IID         int        `json:"iid"`
+	ProjectID   int        `json:"project_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	State       string     `json:"state"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+	WebURL      string     `json:"web_url"`
+}
+
+// ListMergeTrainsOptions represents the available ListProjectMergeTrains()
+// and ListMergeRequestsInTrain() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-trains-for-a-project
+type ListMergeTrainsOptions struct {
+	ListOptions
+	Scope *string `url:"scope,omitempty" json:"scope,omitempty"`
+	Sort  *string `url:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// ListProjectMergeTrains gets a list of all merge trains of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-trains-for-a-project
+func (s *MergeTrainsService) ListProjectMergeTrains(pid interface{}, opt *ListMergeTrainsOptions, options ...RequestOptionFunc) ([]*MergeTrain, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_trains", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mts []*MergeTrain
+	resp, err := s.client.Do(req, &mts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mts, resp, err
+}
+
+// ListMergeRequestsInTrain gets a list of the merge requests in the merge
+// train of the given target branch.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#list-merge-requests-in-a-merge-train
+func (s *MergeTrainsService) ListMergeRequestsInTrain(pid interface{}, targetBranch string, opt *ListMergeTrainsOptions, options ...RequestOptionFunc) ([]*MergeTrain, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_trains/%s", pathEscape(project), url.PathEscape(targetBranch))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mts []*MergeTrain
+	resp, err := s.client.Do(req, &mts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mts, resp, err
+}
+
+// GetMergeRequestOnTrain gets the merge train information of a single merge
+// request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#get-the-status-of-a-merge-request-on-a-merge-train
+func (s *MergeTrainsService) GetMergeRequestOnTrain(pid interface{}, mergeRequest int, options ...RequestOptionFunc) (*MergeTrain, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_trains/merge_requests/%d", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mt := new(MergeTrain)
+	resp, err := s.client.Do(req, mt)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mt, resp, err
+}
+
+// AddMergeRequestToMergeTrainOptions represents the available
+// AddMergeRequestToTrain() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#add-a-merge-request-to-a-merge-train
+type AddMergeRequestToMergeTrainOptions struct {
+	WhenPipelineSucceeds *bool   `url:"when_pipeline_succeeds,omitempty" json:"when_pipeline_succeeds,omitempty"`
+	SHA                  *string `url:"sha,omitempty" json:"sha,omitempty"`
+	Squash               *bool   `url:"squash,omitempty" json:"squash,omitempty"`
+}
+
+// AddMergeRequestToTrain adds a merge request to the merge train of its
+// target branch. It returns the merge requests in that merge train.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/merge_trains.html#add-a-merge-request-to-a-merge-train
+func (s *MergeTrainsService) AddMergeRequestToTrain(pid interface{}, mergeRequest int, opt *AddMergeRequestToMergeTrainOptions, options ...RequestOptionFunc) ([]*MergeTrain, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/merge_trains/merge_requests/%d", pathEscape(project), mergeRequest)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mts []*MergeTrain
+	resp, err := s.client.Do(req, &mts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mts, resp, err
+}
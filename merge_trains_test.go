@@ -0,0 +1,138 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMergeTrain = `{
+	"id": 110,
+	"merge_request": {
+		"id": 126,
+		"iid": 59,
+		"project_id": 20,
+		"title": "Test MR 1580978354",
+		"description": "",
+		"state": "merged",
+		"created_at": "2020-02-06T08:39:14.883Z",
+		"updated_at": "2020-02-06T08:40:57.038Z",
+		"web_url": "http://local.gitlab.test:8181/root/merge-train-race-condition/-/merge_requests/59"
+	},
+	"user": {"id": 1, "name": "Administrator", "username": "root", "state": "active"},
+	"pipeline": {
+		"id": 246,
+		"sha": "bcc17a8ffd51be1afe45605e714085df28b80b13",
+		"ref": "refs/merge-requests/59/train",
+		"status": "success",
+		"created_at": "2020-02-06T08:40:42.410Z",
+		"updated_at": "2020-02-06T08:40:46.912Z",
+		"web_url": "http://local.gitlab.test:8181/root/merge-train-race-condition/pipelines/246"
+	},
+	"created_at": "2020-02-06T08:39:47.217Z",
+	"updated_at": "2020-02-06T08:40:57.720Z",
+	"target_branch": "feature-1580973432",
+	"status": "merged",
+	"merged_at": "2020-02-06T08:40:57.719Z",
+	"duration": 70
+}`
+
+func wantMergeTrain() *MergeTrain {
+	date := func(s string) *time.Time {
+		t, _ := time.Parse(time.RFC3339, s)
+		return &t
+	}
+	return &MergeTrain{
+		ID: 110,
+		MergeRequest: &MergeTrainMergeRequest{
+			ID:        126,
+			IID:       59,
+			ProjectID: 20,
+			Title:     "Test MR 1580978354",
+			State:     "merged",
+			CreatedAt: date("2020-02-06T08:39:14.883Z"),
+			UpdatedAt: date("2020-02-06T08:40:57.038Z"),
+			WebURL:    "http://local.gitlab.test:8181/root/merge-train-race-condition/-/merge_requests/59",
+		},
+		User: &BasicUser{ID: 1, Name: "Administrator", Username: "root", State: "active"},
+		Pipeline: &PipelineInfo{
+			ID:        246,
+			SHA:       "bcc17a8ffd51be1afe45605e714085df28b80b13",
+			Ref:       "refs/merge-requests/59/train",
+			Status:    "success",
+			CreatedAt: date("2020-02-06T08:40:42.410Z"),
+			UpdatedAt: date("2020-02-06T08:40:46.912Z"),
+			WebURL:    "http://local.gitlab.test:8181/root/merge-train-race-condition/pipelines/246",
+		},
+		CreatedAt:    date("2020-02-06T08:39:47.217Z"),
+		UpdatedAt:    date("2020-02-06T08:40:57.720Z"),
+		TargetBranch: "feature-1580973432",
+		Status:       MergeTrainMerged,
+		MergedAt:     date("2020-02-06T08:40:57.719Z"),
+		Duration:     70,
+	}
+}
+
+func TestListProjectMergeTrains(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_trains", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/merge_trains?scope=complete")
+		fmt.Fprintf(w, "[%s]", testMergeTrain)
+	})
+
+	opt := &ListMergeTrainsOptions{Scope: String("complete")}
+	mts, _, err := client.MergeTrains.ListProjectMergeTrains(1, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*MergeTrain{wantMergeTrain()}, mts)
+}
+
+func TestListMergeRequestsInTrain(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_trains/feature-1580973432", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprintf(w, "[%s]", testMergeTrain)
+	})
+
+	mts, _, err := client.MergeTrains.ListMergeRequestsInTrain(1, "feature-1580973432", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*MergeTrain{wantMergeTrain()}, mts)
+}
+
+func TestGetMergeRequestOnTrain(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_trains/merge_requests/59", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, testMergeTrain)
+	})
+
+	mt, _, err := client.MergeTrains.GetMergeRequestOnTrain(1, 59)
+	require.NoError(t, err)
+	assert.Equal(t, wantMergeTrain(), mt)
+}
+
+func TestAddMergeRequestToTrain(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/merge_trains/merge_requests/59", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"when_pipeline_succeeds":true,"squash":true}`)
+		fmt.Fprintf(w, "[%s]", testMergeTrain)
+	})
+
+	opt := &AddMergeRequestToMergeTrainOptions{WhenPipelineSucceeds: Bool(true), Squash: Bool(true)}
+	mts, _, err := client.MergeTrains.AddMergeRequestToTrain(1, 59, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*MergeTrain{wantMergeTrain()}, mts)
+}
@@ -266,3 +266,56 @@ func (s *MilestonesService) GetMilestoneMergeRequests(pid interface{}, milestone
 
 	return mr, resp, err
 }
+
+// PromoteMilestone promotes a project milestone to a group milestone. The
+// milestone is moved to the parent group of the project, which must exist.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#promote-project-milestone-to-a-group-milestone
+func (s *MilestonesService) PromoteMilestone(pid interface{}, milestone int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/milestones/%d/promote", pathEscape(project), milestone)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// GetMilestoneBurndownChartEventsOptions represents the available
+// GetMilestoneBurndownChartEvents() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#get-all-burndown-chart-events-for-a-single-milestone
+type GetMilestoneBurndownChartEventsOptions ListOptions
+
+// GetMilestoneBurndownChartEvents gets all burndown chart events of a single
+// project milestone.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/milestones.html#get-all-burndown-chart-events-for-a-single-milestone
+func (s *MilestonesService) GetMilestoneBurndownChartEvents(pid interface{}, milestone int, opt *GetMilestoneBurndownChartEventsOptions, options ...RequestOptionFunc) ([]*BurndownChartEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/milestones/%d/burndown_events", pathEscape(project), milestone)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var be []*BurndownChartEvent
+	resp, err := s.client.Do(req, &be)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return be, resp, err
+}
@@ -0,0 +1,70 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMilestoneIssues(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/issues", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/milestones/12/issues?page=2&per_page=10")
+		fmt.Fprint(w, `[{"id": 1, "iid": 3, "project_id": 5}]`)
+	})
+
+	issues, _, err := client.Milestones.GetMilestoneIssues(5, 12, &GetMilestoneIssuesOptions{Page: 2, PerPage: 10})
+	require.NoError(t, err)
+	assert.Equal(t, []*Issue{{ID: 1, IID: 3, ProjectID: 5}}, issues)
+}
+
+func TestGetMilestoneMergeRequests(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/merge_requests", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1, "iid": 3, "project_id": 5}]`)
+	})
+
+	mrs, _, err := client.Milestones.GetMilestoneMergeRequests(5, 12, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*MergeRequest{{ID: 1, IID: 3, ProjectID: 5}}, mrs)
+}
+
+func TestPromoteMilestone(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/promote", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+	})
+
+	resp, err := client.Milestones.PromoteMilestone(5, 12)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestGetMilestoneBurndownChartEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/milestones/12/burndown_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"created_at": "2019-03-30T22:16:05.000Z", "weight": 2, "action": "created"}]`)
+	})
+
+	events, _, err := client.Milestones.GetMilestoneBurndownChartEvents(5, 12, nil)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2019, time.March, 30, 22, 16, 5, 0, time.UTC)
+	want := []*BurndownChartEvent{{CreatedAt: &createdAt, Weight: Int(2), Action: String("created")}}
+	assert.Equal(t, want, events)
+}
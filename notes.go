@@ -68,20 +68,40 @@ type Note struct {
 	NoteableIID int `json:"noteable_iid"`
 }
 
-// NotePosition represents the position attributes of a note.
+// NotePosition represents the position attributes of a note. It is also
+// used to place a new diff discussion on a merge request, in which case the
+// SHAs must match the diff refs of the merge request version being commented
+// on and PositionType is "text" (or "image" for image diffs). Set NewLine to
+// comment on an added line, OldLine on a removed line and both for an
+// unchanged line.
 type NotePosition struct {
-	BaseSHA      string `json:"base_sha"`
-	StartSHA     string `json:"start_sha"`
-	HeadSHA      string `json:"head_sha"`
-	PositionType string `json:"position_type"`
-	NewPath      string `json:"new_path,omitempty"`
-	NewLine      int    `json:"new_line,omitempty"`
-	OldPath      string `json:"old_path,omitempty"`
-	OldLine      int    `json:"old_line,omitempty"`
-	Width        int    `json:"width,omitempty"`
-	Height       int    `json:"height,omitempty"`
-	X            int    `json:"x,omitempty"`
-	Y            int    `json:"y,omitempty"`
+	BaseSHA      string         `json:"base_sha"`
+	StartSHA     string         `json:"start_sha"`
+	HeadSHA      string         `json:"head_sha"`
+	PositionType string         `json:"position_type"`
+	NewPath      string         `json:"new_path,omitempty"`
+	NewLine      int            `json:"new_line,omitempty"`
+	OldPath      string         `json:"old_path,omitempty"`
+	OldLine      int            `json:"old_line,omitempty"`
+	LineRange    *NoteLineRange `json:"line_range,omitempty"`
+	Width        int            `json:"width,omitempty"`
+	Height       int            `json:"height,omitempty"`
+	X            int            `json:"x,omitempty"`
+	Y            int            `json:"y,omitempty"`
+}
+
+// NoteLineRange represents the range of lines of a multi-line diff note.
+type NoteLineRange struct {
+	Start *NoteLinePosition `json:"start,omitempty"`
+	End   *NoteLinePosition `json:"end,omitempty"`
+}
+
+// NoteLinePosition represents one end of the line range of a diff note.
+type NoteLinePosition struct {
+	LineCode string `json:"line_code,omitempty"`
+	Type     string `json:"type,omitempty"`
+	OldLine  int    `json:"old_line,omitempty"`
+	NewLine  int    `json:"new_line,omitempty"`
 }
 
 func (n Note) String() string {
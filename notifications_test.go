@@ -0,0 +1,75 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGlobalNotificationSettings(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"level":"participating","notification_email":"admin@example.com"}`)
+	})
+
+	settings, _, err := client.NotificationSettings.GetGlobalSettings()
+	require.NoError(t, err)
+
+	want := &NotificationSettings{Level: ParticipatingNotificationLevel, NotificationEmail: "admin@example.com"}
+	assert.Equal(t, want, settings)
+}
+
+func TestUpdateGlobalNotificationSettingsGlobalLevel(t *testing.T) {
+	_, server, client := setup(t)
+	defer teardown(server)
+
+	opt := &NotificationSettingsOptions{Level: NotificationLevel(GlobalNotificationLevel)}
+	_, _, err := client.NotificationSettings.UpdateGlobalSettings(opt)
+	assert.Error(t, err)
+}
+
+func TestUpdateSettingsForProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"level":"custom","new_note":false,"new_release":true,"push_to_merge_request":true}`)
+		fmt.Fprint(w, `{"level":"custom","events":{"new_release":true,"push_to_merge_request":true}}`)
+	})
+
+	opt := &NotificationSettingsOptions{
+		Level:              NotificationLevel(CustomNotificationLevel),
+		NewNote:            Bool(false),
+		NewRelease:         Bool(true),
+		PushToMergeRequest: Bool(true),
+	}
+	settings, _, err := client.NotificationSettings.UpdateSettingsForProject(1, opt)
+	require.NoError(t, err)
+
+	want := &NotificationSettings{
+		Level:  CustomNotificationLevel,
+		Events: &NotificationEvents{NewRelease: true, PushToMergeRequest: true},
+	}
+	assert.Equal(t, want, settings)
+}
+
+func TestGetSettingsForGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/notification_settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"level":"watch"}`)
+	})
+
+	settings, _, err := client.NotificationSettings.GetSettingsForGroup(1)
+	require.NoError(t, err)
+	assert.Equal(t, &NotificationSettings{Level: WatchNotificationLevel}, settings)
+}
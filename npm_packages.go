@@ -0,0 +1,107 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// NPMPackagesService handles communication with the NPM package registry
+// methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html
+type NPMPackagesService struct {
+	client *Client
+}
+
+// NPMPackageMetadata represents the metadata of an NPM package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#metadata
+type NPMPackageMetadata struct {
+	Name     string                        `json:"name"`
+	Versions map[string]*NPMPackageVersion `json:"versions"`
+	DistTags map[string]string             `json:"dist-tags"`
+}
+
+// NPMPackageVersion represents a single version of an NPM package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#metadata
+type NPMPackageVersion struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	Dependencies map[string]string `json:"dependencies"`
+	Dist         struct {
+		Shasum    string `json:"shasum"`
+		Tarball   string `json:"tarball"`
+		Integrity string `json:"integrity"`
+	} `json:"dist"`
+}
+
+// GetPackageMetadata returns the metadata of an NPM package. Scoped package
+// names like "@scope/name" are escaped by the client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#metadata
+func (s *NPMPackagesService) GetPackageMetadata(pid interface{}, packageName string, options ...RequestOptionFunc) (*NPMPackageMetadata, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/npm/%s", pathEscape(project), pathEscape(packageName))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(NPMPackageMetadata)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// DownloadPackageFile downloads an NPM package tarball. The complete file is
+// kept in memory, use DownloadPackageFileToWriter for large files.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#download-a-package
+func (s *NPMPackagesService) DownloadPackageFile(pid interface{}, packageName, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	var f bytes.Buffer
+	resp, err := s.DownloadPackageFileToWriter(pid, packageName, fileName, &f, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return f.Bytes(), resp, err
+}
+
+// DownloadPackageFileToWriter downloads an NPM package tarball and streams
+// its content to w as it arrives.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/npm.html#download-a-package
+func (s *NPMPackagesService) DownloadPackageFileToWriter(pid interface{}, packageName, fileName string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/packages/npm/%s/-/%s",
+		pathEscape(project),
+		pathEscape(packageName),
+		pathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
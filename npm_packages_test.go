@@ -0,0 +1,64 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPMGetPackageMetadata(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/npm/@scope/my-package", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/packages/npm/@scope%2Fmy-package")
+		fmt.Fprint(w, `{
+			"name": "@scope/my-package",
+			"versions": {
+				"0.0.2": {
+					"name": "@scope/my-package",
+					"version": "0.0.2",
+					"dependencies": {"lodash": "^4.17.21"},
+					"dist": {
+						"shasum": "93abb605b1110c0e3cca0a5b805e5cb01ac4ca9b",
+						"tarball": "https://gitlab.example.com/api/v4/projects/1/packages/npm/@scope/my-package/-/@scope/my-package-0.0.2.tgz",
+						"integrity": "sha512-abc"
+					}
+				}
+			},
+			"dist-tags": {"latest": "0.0.2"}
+		}`)
+	})
+
+	metadata, _, err := client.NPMPackages.GetPackageMetadata(1, "@scope/my-package")
+	require.NoError(t, err)
+
+	assert.Equal(t, "@scope/my-package", metadata.Name)
+	assert.Equal(t, map[string]string{"latest": "0.0.2"}, metadata.DistTags)
+	require.Contains(t, metadata.Versions, "0.0.2")
+	version := metadata.Versions["0.0.2"]
+	assert.Equal(t, "0.0.2", version.Version)
+	assert.Equal(t, map[string]string{"lodash": "^4.17.21"}, version.Dependencies)
+	assert.Equal(t, "93abb605b1110c0e3cca0a5b805e5cb01ac4ca9b", version.Dist.Shasum)
+	assert.Equal(t, "sha512-abc", version.Dist.Integrity)
+}
+
+func TestNPMDownloadPackageFileToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/npm/my-package/-/my-package-0.0.2.tgz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "tarball")
+	})
+
+	var b bytes.Buffer
+	_, err := client.NPMPackages.DownloadPackageFileToWriter(1, "my-package", "my-package-0.0.2.tgz", &b)
+	require.NoError(t, err)
+	assert.Equal(t, "tarball", b.String())
+}
@@ -0,0 +1,194 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+var (
+	// ErrPackageDeletionForbidden is returned when GitLab rejects the deletion
+	// of a package or package file, because package deletion is disabled on
+	// the instance or the user isn't allowed to delete packages. It wraps the
+	// ErrorResponse of GitLab, so check for it using errors.Is.
+	ErrPackageDeletionForbidden = errors.New("Package deletion is disabled or not allowed for this user")
+)
+
+// PackagesService handles communication with the packages related methods
+// of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/packages.html
+type PackagesService struct {
+	client *Client
+}
+
+// Package represents a GitLab package.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/packages.html
+type Package struct {
+	ID          int           `json:"id"`
+	Name        string        `json:"name"`
+	Version     string        `json:"version"`
+	PackageType string        `json:"package_type"`
+	Status      string        `json:"status"`
+	Links       *PackageLinks `json:"_links"`
+	CreatedAt   *time.Time    `json:"created_at"`
+}
+
+func (s Package) String() string {
+	return Stringify(s)
+}
+
+// PackageLinks holds links for itself and deleting.
+type PackageLinks struct {
+	WebPath       string `json:"web_path"`
+	DeleteAPIPath string `json:"delete_api_path"`
+}
+
+func (s PackageLinks) String() string {
+	return Stringify(s)
+}
+
+// PackageFile represents one file contained within a package.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/packages.html
+type PackageFile struct {
+	ID         int        `json:"id"`
+	PackageID  int        `json:"package_id"`
+	CreatedAt  *time.Time `json:"created_at"`
+	FileName   string     `json:"file_name"`
+	Size       int        `json:"size"`
+	FileMD5    string     `json:"file_md5"`
+	FileSHA1   string     `json:"file_sha1"`
+	FileSHA256 string     `json:"file_sha256"`
+}
+
+func (s PackageFile) String() string {
+	return Stringify(s)
+}
+
+// ListProjectPackagesOptions represents the available ListProjectPackages()
+// options.
+//
+// Set Pagination to "keyset" to use keyset pagination. In that case the
+// Response.NextLink field contains the link to the next page, which can be
+// followed using the WithKeysetPaginationParameters request option.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages.html#within-a-project
+type ListProjectPackagesOptions struct {
+	ListOptions
+	Pagination         *string `url:"pagination,omitempty" json:"pagination,omitempty"`
+	OrderBy            *string `url:"order_by,omitempty" json:"order_by,omitempty"`
+	Sort               *string `url:"sort,omitempty" json:"sort,omitempty"`
+	PackageType        *string `url:"package_type,omitempty" json:"package_type,omitempty"`
+	PackageName        *string `url:"package_name,omitempty" json:"package_name,omitempty"`
+	IncludeVersionless *bool   `url:"include_versionless,omitempty" json:"include_versionless,omitempty"`
+	Status             *string `url:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListProjectPackages gets a list of packages in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages.html#within-a-project
+func (s *PackagesService) ListProjectPackages(pid interface{}, opt *ListProjectPackagesOptions, options ...RequestOptionFunc) ([]*Package, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ps []*Package
+	resp, err := s.client.Do(req, &ps)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ps, resp, err
+}
+
+// ListPackageFilesOptions represents the available ListPackageFiles()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages.html#list-package-files
+type ListPackageFilesOptions ListOptions
+
+// ListPackageFiles gets a list of files that are within a package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages.html#list-package-files
+func (s *PackagesService) ListPackageFiles(pid interface{}, pkg int, opt *ListPackageFilesOptions, options ...RequestOptionFunc) ([]*PackageFile, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/%d/package_files", pathEscape(project), pkg)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pfs []*PackageFile
+	resp, err := s.client.Do(req, &pfs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pfs, resp, err
+}
+
+// DeleteProjectPackage deletes a package in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages.html#delete-a-project-package
+func (s *PackagesService) DeleteProjectPackage(pid interface{}, pkg int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/%d", pathEscape(project), pkg)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrPackageDeletionForbidden)
+	}
+
+	return resp, err
+}
+
+// DeletePackageFile deletes a file of a package in a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages.html#delete-a-package-file
+func (s *PackagesService) DeletePackageFile(pid interface{}, pkg, file int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/%d/package_files/%d", pathEscape(project), pkg, file)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, wrapErrorResponse(err, http.StatusForbidden, "", ErrPackageDeletionForbidden)
+	}
+
+	return resp, err
+}
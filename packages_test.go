@@ -0,0 +1,156 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPackagesService_ListProjectPackages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 3, "name": "Hello/0.1@mycompany/stable", "version": "0.1", "package_type": "conan"}]`)
+	})
+
+	ps, _, err := client.Packages.ListProjectPackages(3, nil)
+	if err != nil {
+		t.Fatalf("Packages.ListProjectPackages returned error: %v", err)
+	}
+
+	want := []*Package{{ID: 3, Name: "Hello/0.1@mycompany/stable", Version: "0.1", PackageType: "conan"}}
+	if !reflect.DeepEqual(want, ps) {
+		t.Errorf("Packages.ListProjectPackages returned %+v, want %+v", ps, want)
+	}
+}
+
+func TestPackagesService_ListProjectPackagesKeyset(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("id_after") {
+		case "":
+			testURL(t, r, "/api/v4/projects/3/packages?order_by=id&pagination=keyset&per_page=1")
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/projects/3/packages?id_after=3&order_by=id&pagination=keyset&per_page=1>; rel="next"`, server.URL))
+			fmt.Fprint(w, `[{"id": 3}]`)
+		case "3":
+			fmt.Fprint(w, `[{"id": 4}]`)
+		}
+	})
+
+	opt := &ListProjectPackagesOptions{
+		ListOptions: ListOptions{PerPage: 1},
+		Pagination:  String("keyset"),
+		OrderBy:     String("id"),
+	}
+
+	ps, resp, err := client.Packages.ListProjectPackages(3, opt)
+	if err != nil {
+		t.Fatalf("Packages.ListProjectPackages returned error: %v", err)
+	}
+	if len(ps) != 1 || ps[0].ID != 3 {
+		t.Errorf("Packages.ListProjectPackages returned %+v", ps)
+	}
+
+	wantLink := server.URL + "/api/v4/projects/3/packages?id_after=3&order_by=id&pagination=keyset&per_page=1"
+	if resp.NextLink != wantLink {
+		t.Fatalf("Response.NextLink is %q, want %q", resp.NextLink, wantLink)
+	}
+
+	ps, resp, err = client.Packages.ListProjectPackages(3, opt, WithKeysetPaginationParameters(resp.NextLink))
+	if err != nil {
+		t.Fatalf("Packages.ListProjectPackages returned error: %v", err)
+	}
+	if len(ps) != 1 || ps[0].ID != 4 {
+		t.Errorf("Packages.ListProjectPackages returned %+v", ps)
+	}
+	if resp.NextLink != "" {
+		t.Errorf("Response.NextLink is %q, want empty", resp.NextLink)
+	}
+}
+
+func TestPackagesService_ListPackageFiles(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4/package_files", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 25, "package_id": 4, "file_name": "my-app-1.5.jar", "size": 2421}]`)
+	})
+
+	pfs, _, err := client.Packages.ListPackageFiles(3, 4, nil)
+	if err != nil {
+		t.Fatalf("Packages.ListPackageFiles returned error: %v", err)
+	}
+
+	want := []*PackageFile{{ID: 25, PackageID: 4, FileName: "my-app-1.5.jar", Size: 2421}}
+	if !reflect.DeepEqual(want, pfs) {
+		t.Errorf("Packages.ListPackageFiles returned %+v, want %+v", pfs, want)
+	}
+}
+
+func TestPackagesService_DeleteProjectPackage(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Packages.DeleteProjectPackage(3, 4)
+	if err != nil {
+		t.Fatalf("Packages.DeleteProjectPackage returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Packages.DeleteProjectPackage returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestPackagesService_DeletePackageFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4/package_files/25", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Packages.DeletePackageFile(3, 4, 25)
+	if err != nil {
+		t.Fatalf("Packages.DeletePackageFile returned error: %v", err)
+	}
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Packages.DeletePackageFile returned status %d, want %d", resp.StatusCode, http.StatusNoContent)
+	}
+}
+
+func TestPackagesService_DeleteProjectPackageForbidden(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages/4", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"message": "403 Forbidden"}`)
+	})
+
+	resp, err := client.Packages.DeleteProjectPackage(3, 4)
+	if !errors.Is(err, ErrPackageDeletionForbidden) {
+		t.Errorf("Packages.DeleteProjectPackage returned error %v, want %v", err, ErrPackageDeletionForbidden)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || !strings.Contains(errResp.Message, "403 Forbidden") {
+		t.Errorf("Packages.DeleteProjectPackage returned error %v, want it to wrap the ErrorResponse", err)
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Errorf("Packages.DeleteProjectPackage returned response %+v, want status 403", resp)
+	}
+}
@@ -0,0 +1,83 @@
+package gitlab
+
+import (
+	"context"
+)
+
+// Scan drives the pagination of any List method that is wrapped by fn. Each
+// call to fn receives the ListOptions for the page to fetch, which fn should
+// copy into the options of the method it wraps, and any request options that
+// fn should pass on to that method. For example:
+//
+//	opt := &gitlab.ListProjectsOptions{Owned: gitlab.Bool(true)}
+//	projects, errs := gitlab.Scan(ctx, func(lo gitlab.ListOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+//		opt.ListOptions = lo
+//		return git.Projects.ListProjects(opt, append(options, gitlab.WithContext(ctx))...)
+//	})
+//	for p := range projects {
+//		...
+//	}
+//	if err := <-errs; err != nil {
+//		...
+//	}
+//
+// All items are sent on the first returned channel, which is closed when the
+// last page is read, when fn returns an error or when ctx is done. The first
+// error encountered (if any) is then sent on the second channel. The second
+// channel is closed after the first one, so it can always be read once the
+// first one is drained.
+//
+// Both offset and keyset pagination are supported. When a response carries a
+// "next" link, the next page is requested with WithKeysetPaginationParameters,
+// otherwise Scan follows the next page number.
+//
+// Consumers that stop reading items before the first channel is closed must
+// cancel ctx, otherwise the goroutine driving the pagination blocks forever.
+//
+// Scan uses type parameters and requires at least Go 1.18.
+func Scan[T any](ctx context.Context, fn func(opt ListOptions, options ...RequestOptionFunc) ([]T, *Response, error)) (<-chan T, <-chan error) {
+	items := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(items)
+
+		var opt ListOptions
+		var options []RequestOptionFunc
+		for {
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			page, resp, err := fn(opt, options...)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, item := range page {
+				select {
+				case items <- item:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			switch {
+			case resp == nil:
+				return
+			case resp.NextLink != "":
+				options = []RequestOptionFunc{WithKeysetPaginationParameters(resp.NextLink)}
+			case resp.NextPage != 0:
+				opt.Page = resp.NextPage
+			default:
+				return
+			}
+		}
+	}()
+
+	return items, errs
+}
@@ -0,0 +1,124 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set(xNextPage, "2")
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.Query().Get("page"))
+		}
+	})
+
+	opt := &ListProjectsOptions{}
+	projects, errs := Scan(context.Background(), func(lo ListOptions, options ...RequestOptionFunc) ([]*Project, *Response, error) {
+		opt.ListOptions = lo
+		return client.Projects.ListProjects(opt, options...)
+	})
+
+	var ids []int
+	for p := range projects {
+		ids = append(ids, p.ID)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Scan returned %v, want %v", ids, want)
+	}
+}
+
+func TestScanKeyset(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/3/packages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("id_after") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/projects/3/packages?id_after=2&order_by=id&pagination=keyset&per_page=2>; rel="next"`, server.URL))
+			fmt.Fprint(w, `[{"id":1},{"id":2}]`)
+		case "2":
+			fmt.Fprint(w, `[{"id":3}]`)
+		default:
+			t.Errorf("unexpected page requested: %s", r.URL.RawQuery)
+		}
+	})
+
+	opt := &ListProjectPackagesOptions{
+		Pagination: String("keyset"),
+		OrderBy:    String("id"),
+	}
+	packages, errs := Scan(context.Background(), func(lo ListOptions, options ...RequestOptionFunc) ([]*Package, *Response, error) {
+		opt.ListOptions = lo
+		return client.Packages.ListProjectPackages(3, opt, options...)
+	})
+
+	var ids []int
+	for p := range packages {
+		ids = append(ids, p.ID)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Scan returned error: %v", err)
+	}
+
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(want, ids) {
+		t.Errorf("Scan returned %v, want %v", ids, want)
+	}
+}
+
+func TestScanError(t *testing.T) {
+	want := errors.New("list failed")
+
+	calls := 0
+	items, errs := Scan(context.Background(), func(lo ListOptions, options ...RequestOptionFunc) ([]int, *Response, error) {
+		calls++
+		return nil, nil, want
+	})
+
+	for range items {
+		t.Errorf("Scan returned an item after an error")
+	}
+	if err := <-errs; err != want {
+		t.Errorf("Scan returned error %v, want %v", err, want)
+	}
+	if calls != 1 {
+		t.Errorf("Scan called fn %d times, want 1", calls)
+	}
+}
+
+func TestScanContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	items, errs := Scan(ctx, func(lo ListOptions, options ...RequestOptionFunc) ([]int, *Response, error) {
+		return []int{1, 2, 3}, &Response{NextPage: lo.Page + 1}, nil
+	})
+
+	<-items
+	cancel()
+
+	for range items {
+	}
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("Scan returned error %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,175 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// PersonalAccessTokensService handles communication with the personal access
+// tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/personal_access_tokens.html
+type PersonalAccessTokensService struct {
+	client *Client
+}
+
+// PersonalAccessToken represents a personal access token. The Token field is
+// only set in the response of RotatePersonalAccessToken, it is never returned
+// again afterwards.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/personal_access_tokens.html
+type PersonalAccessToken struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Revoked    bool       `json:"revoked"`
+	CreatedAt  *time.Time `json:"created_at"`
+	Scopes     []string   `json:"scopes"`
+	UserID     int        `json:"user_id"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Active     bool       `json:"active"`
+	ExpiresAt  *ISOTime   `json:"expires_at"`
+	Token      string     `json:"token"`
+}
+
+func (p PersonalAccessToken) String() string {
+	return Stringify(p)
+}
+
+// ListPersonalAccessTokensOptions represents the available
+// ListPersonalAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#list-personal-access-tokens
+type ListPersonalAccessTokensOptions struct {
+	ListOptions
+	UserID         *int       `url:"user_id,omitempty" json:"user_id,omitempty"`
+	State          *string    `url:"state,omitempty" json:"state,omitempty"`
+	Revoked        *bool      `url:"revoked,omitempty" json:"revoked,omitempty"`
+	Search         *string    `url:"search,omitempty" json:"search,omitempty"`
+	CreatedAfter   *time.Time `url:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore  *time.Time `url:"created_before,omitempty" json:"created_before,omitempty"`
+	LastUsedAfter  *time.Time `url:"last_used_after,omitempty" json:"last_used_after,omitempty"`
+	LastUsedBefore *time.Time `url:"last_used_before,omitempty" json:"last_used_before,omitempty"`
+}
+
+// ListPersonalAccessTokens gets a list of all personal access tokens. Non
+// admin users only get their own tokens.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#list-personal-access-tokens
+func (s *PersonalAccessTokensService) ListPersonalAccessTokens(opt *ListPersonalAccessTokensOptions, options ...RequestOptionFunc) ([]*PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest("GET", "personal_access_tokens", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pats []*PersonalAccessToken
+	resp, err := s.client.Do(req, &pats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pats, resp, err
+}
+
+// GetSinglePersonalAccessToken gets a single personal access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-personal-access-token-id
+func (s *PersonalAccessTokensService) GetSinglePersonalAccessToken(tokenID int, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("personal_access_tokens/%d", tokenID)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// GetSinglePersonalAccessTokenSelf gets the personal access token used to
+// authenticate the request.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header
+func (s *PersonalAccessTokensService) GetSinglePersonalAccessTokenSelf(options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	req, err := s.client.NewRequest("GET", "personal_access_tokens/self", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RevokePersonalAccessToken revokes a personal access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-personal-access-token-id-1
+func (s *PersonalAccessTokensService) RevokePersonalAccessToken(tokenID int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("personal_access_tokens/%d", tokenID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RevokePersonalAccessTokenSelf revokes the personal access token used to
+// authenticate the request. Later requests with the same token will fail.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#using-a-request-header-1
+func (s *PersonalAccessTokensService) RevokePersonalAccessTokenSelf(options ...RequestOptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest("DELETE", "personal_access_tokens/self", nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// RotatePersonalAccessTokenOptions represents the available
+// RotatePersonalAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+type RotatePersonalAccessTokenOptions struct {
+	ExpiresAt *ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// RotatePersonalAccessToken revokes a personal access token and returns a new
+// one. The new token value is only available in the returned
+// PersonalAccessToken.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/personal_access_tokens.html#rotate-a-personal-access-token
+func (s *PersonalAccessTokensService) RotatePersonalAccessToken(tokenID int, opt *RotatePersonalAccessTokenOptions, options ...RequestOptionFunc) (*PersonalAccessToken, *Response, error) {
+	u := fmt.Sprintf("personal_access_tokens/%d/rotate", tokenID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(PersonalAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
@@ -0,0 +1,96 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"id": 43, "name": "mine", "user_id": 3, "active": true, "scopes": ["api"], "token": "s3cr3t"}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.RotatePersonalAccessToken(42, nil)
+	require.NoError(t, err)
+
+	want := &PersonalAccessToken{ID: 43, Name: "mine", UserID: 3, Active: true, Scopes: []string{"api"}, Token: "s3cr3t"}
+	assert.Equal(t, want, pat)
+}
+
+func TestListPersonalAccessTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/personal_access_tokens?last_used_before=2021-03-01T00%3A00%3A00Z&revoked=false&state=active&user_id=3")
+		fmt.Fprint(w, `[{"id": 42, "name": "mine", "user_id": 3, "active": true, "scopes": ["api"], "last_used_at": "2021-02-20T10:00:00Z"}]`)
+	})
+
+	lastUsedBefore := time.Date(2021, time.March, 1, 0, 0, 0, 0, time.UTC)
+	opt := &ListPersonalAccessTokensOptions{
+		UserID:         Int(3),
+		State:          String("active"),
+		Revoked:        Bool(false),
+		LastUsedBefore: &lastUsedBefore,
+	}
+	pats, _, err := client.PersonalAccessTokens.ListPersonalAccessTokens(opt)
+	require.NoError(t, err)
+
+	lastUsedAt := time.Date(2021, time.February, 20, 10, 0, 0, 0, time.UTC)
+	want := []*PersonalAccessToken{{ID: 42, Name: "mine", UserID: 3, Active: true, Scopes: []string{"api"}, LastUsedAt: &lastUsedAt}}
+	assert.Equal(t, want, pats)
+}
+
+func TestGetSinglePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 42, "name": "mine", "expires_at": "2021-12-31"}`)
+	})
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 43, "name": "ci"}`)
+	})
+
+	pat, _, err := client.PersonalAccessTokens.GetSinglePersonalAccessToken(42)
+	require.NoError(t, err)
+
+	expiresAt := ISOTime(time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC))
+	assert.Equal(t, &PersonalAccessToken{ID: 42, Name: "mine", ExpiresAt: &expiresAt}, pat)
+
+	pat, _, err = client.PersonalAccessTokens.GetSinglePersonalAccessTokenSelf()
+	require.NoError(t, err)
+	assert.Equal(t, &PersonalAccessToken{ID: 43, Name: "ci"}, pat)
+}
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/personal_access_tokens/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v4/personal_access_tokens/self", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.PersonalAccessTokens.RevokePersonalAccessToken(42)
+	require.NoError(t, err)
+
+	_, err = client.PersonalAccessTokens.RevokePersonalAccessTokenSelf()
+	require.NoError(t, err)
+}
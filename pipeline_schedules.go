@@ -17,10 +17,15 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"time"
 )
 
+// ErrPipelineScheduleVariableNotFound is returned when trying to edit or
+// delete a pipeline schedule variable that does not exist.
+var ErrPipelineScheduleVariableNotFound = errors.New("Pipeline schedule variable does not exist")
+
 // PipelineSchedulesService handles communication with the pipeline
 // schedules related methods of the GitLab API.
 //
@@ -276,7 +281,9 @@ type EditPipelineScheduleVariableOptions struct {
 	VariableType *string `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 }
 
-// EditPipelineScheduleVariable creates a pipeline schedule variable.
+// EditPipelineScheduleVariable updates an existing pipeline schedule variable.
+// If the schedule exists but the variable does not,
+// ErrPipelineScheduleVariableNotFound is returned.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html#edit-a-pipeline-schedule-variable
@@ -285,7 +292,7 @@ func (s *PipelineSchedulesService) EditPipelineScheduleVariable(pid interface{},
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, key)
+	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, pathEscape(key))
 
 	req, err := s.client.NewRequest("PUT", u, opt, options)
 	if err != nil {
@@ -295,13 +302,21 @@ func (s *PipelineSchedulesService) EditPipelineScheduleVariable(pid interface{},
 	p := new(PipelineVariable)
 	resp, err := s.client.Do(req, p)
 	if err != nil {
-		return nil, resp, err
+		// GitLab returns a 404 both when the variable doesn't exist and when
+		// the project or the schedule doesn't exist, so check if the
+		// schedule exists.
+		return nil, resp, probeNotFound(err, func() (*Response, error) {
+			_, resp, err := s.GetPipelineSchedule(pid, schedule, options...)
+			return resp, err
+		}, ErrPipelineScheduleVariableNotFound, nil)
 	}
 
 	return p, resp, err
 }
 
-// DeletePipelineScheduleVariable creates a pipeline schedule variable.
+// DeletePipelineScheduleVariable deletes a pipeline schedule variable. If the
+// schedule exists but the variable does not,
+// ErrPipelineScheduleVariableNotFound is returned.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/pipeline_schedules.html#delete-a-pipeline-schedule-variable
@@ -310,7 +325,7 @@ func (s *PipelineSchedulesService) DeletePipelineScheduleVariable(pid interface{
 	if err != nil {
 		return nil, nil, err
 	}
-	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, key)
+	u := fmt.Sprintf("projects/%s/pipeline_schedules/%d/variables/%s", pathEscape(project), schedule, pathEscape(key))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -320,7 +335,13 @@ func (s *PipelineSchedulesService) DeletePipelineScheduleVariable(pid interface{
 	p := new(PipelineVariable)
 	resp, err := s.client.Do(req, p)
 	if err != nil {
-		return nil, resp, err
+		// GitLab returns a 404 both when the variable doesn't exist and when
+		// the project or the schedule doesn't exist, so check if the
+		// schedule exists.
+		return nil, resp, probeNotFound(err, func() (*Response, error) {
+			_, resp, err := s.GetPipelineSchedule(pid, schedule, options...)
+			return resp, err
+		}, ErrPipelineScheduleVariableNotFound, nil)
 	}
 
 	return p, resp, err
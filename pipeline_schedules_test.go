@@ -0,0 +1,127 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreatePipelineScheduleVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"NEW_VARIABLE","value":"new value","variable_type":"file"}`)
+		fmt.Fprint(w, `{"key":"NEW_VARIABLE","value":"new value","variable_type":"file"}`)
+	})
+
+	opt := &CreatePipelineScheduleVariableOptions{
+		Key:          String("NEW_VARIABLE"),
+		Value:        String("new value"),
+		VariableType: String("file"),
+	}
+	variable, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(1, 13, opt)
+	if err != nil {
+		t.Errorf("PipelineSchedules.CreatePipelineScheduleVariable returned error: %v", err)
+	}
+
+	want := &PipelineVariable{Key: "NEW_VARIABLE", Value: "new value", VariableType: "file"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("PipelineSchedules.CreatePipelineScheduleVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestEditPipelineScheduleVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13/variables/NEW_VARIABLE", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"value":"updated value"}`)
+		fmt.Fprint(w, `{"key":"NEW_VARIABLE","value":"updated value","variable_type":"env_var"}`)
+	})
+
+	opt := &EditPipelineScheduleVariableOptions{Value: String("updated value")}
+	variable, _, err := client.PipelineSchedules.EditPipelineScheduleVariable(1, 13, "NEW_VARIABLE", opt)
+	if err != nil {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned error: %v", err)
+	}
+
+	want := &PipelineVariable{Key: "NEW_VARIABLE", Value: "updated value", VariableType: "env_var"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned %+v, want %+v", variable, want)
+	}
+}
+
+func TestEditPipelineScheduleVariableNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13/variables/MISSING", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Variable Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":13}`)
+	})
+
+	opt := &EditPipelineScheduleVariableOptions{Value: String("updated value")}
+	variable, resp, err := client.PipelineSchedules.EditPipelineScheduleVariable(1, 13, "MISSING", opt)
+	if !errors.Is(err, ErrPipelineScheduleVariableNotFound) {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned error %v, want %v", err, ErrPipelineScheduleVariableNotFound)
+	}
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned error %v, want it to wrap the 404 ErrorResponse", err)
+	}
+	if variable != nil {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned %+v, want nil", variable)
+	}
+	if resp == nil || resp.StatusCode != http.StatusNotFound {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned response %+v, want status 404", resp)
+	}
+}
+
+func TestEditPipelineScheduleVariableScheduleNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Pipeline Schedule Not Found"}`)
+	})
+
+	opt := &EditPipelineScheduleVariableOptions{Value: String("updated value")}
+	_, _, err := client.PipelineSchedules.EditPipelineScheduleVariable(1, 13, "MISSING", opt)
+	if errors.Is(err, ErrPipelineScheduleVariableNotFound) {
+		t.Fatalf("PipelineSchedules.EditPipelineScheduleVariable returned %v for a missing schedule", err)
+	}
+	if errResp, ok := err.(*ErrorResponse); !ok || errResp.Response.StatusCode != http.StatusNotFound {
+		t.Errorf("PipelineSchedules.EditPipelineScheduleVariable returned error %v, want a 404 *ErrorResponse", err)
+	}
+}
+
+func TestDeletePipelineScheduleVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipeline_schedules/13/variables/NEW_VARIABLE", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		fmt.Fprint(w, `{"key":"NEW_VARIABLE","value":"new value","variable_type":"env_var"}`)
+	})
+
+	variable, _, err := client.PipelineSchedules.DeletePipelineScheduleVariable(1, 13, "NEW_VARIABLE")
+	if err != nil {
+		t.Errorf("PipelineSchedules.DeletePipelineScheduleVariable returned error: %v", err)
+	}
+
+	want := &PipelineVariable{Key: "NEW_VARIABLE", Value: "new value", VariableType: "env_var"}
+	if !reflect.DeepEqual(want, variable) {
+		t.Errorf("PipelineSchedules.DeletePipelineScheduleVariable returned %+v, want %+v", variable, want)
+	}
+}
@@ -84,7 +84,9 @@ func (p Pipeline) String() string {
 // on other assets, like Commit.
 type PipelineInfo struct {
 	ID        int        `json:"id"`
+	ProjectID int        `json:"project_id"`
 	Status    string     `json:"status"`
+	Source    string     `json:"source"`
 	Ref       string     `json:"ref"`
 	SHA       string     `json:"sha"`
 	WebURL    string     `json:"web_url"`
@@ -97,6 +99,9 @@ func (p PipelineInfo) String() string {
 }
 
 // ListProjectPipelinesOptions represents the available ListProjectPipelines() options.
+// Source filters on how the pipeline was triggered, e.g. "push", "web",
+// "schedule", "api" or "merge_request_event". Combined with an OrderBy of
+// "updated_at", UpdatedAfter allows to incrementally fetch changed pipelines.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/pipelines.html#list-project-pipelines
 type ListProjectPipelinesOptions struct {
@@ -104,6 +109,7 @@ type ListProjectPipelinesOptions struct {
 	Scope         *string          `url:"scope,omitempty" json:"scope,omitempty"`
 	Status        *BuildStateValue `url:"status,omitempty" json:"status,omitempty"`
 	Ref           *string          `url:"ref,omitempty" json:"ref,omitempty"`
+	Source        *string          `url:"source,omitempty" json:"source,omitempty"`
 	SHA           *string          `url:"sha,omitempty" json:"sha,omitempty"`
 	YamlErrors    *bool            `url:"yaml_errors,omitempty" json:"yaml_errors,omitempty"`
 	Name          *string          `url:"name,omitempty" json:"name,omitempty"`
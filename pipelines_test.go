@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"reflect"
 	"testing"
+	"time"
 )
 
 func TestListProjectPipelines(t *testing.T) {
@@ -143,3 +144,34 @@ func TestDeletePipeline(t *testing.T) {
 		t.Errorf("Pipelines.DeletePipeline returned error: %v", err)
 	}
 }
+
+func TestListProjectPipelinesWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/pipelines?name=nightly&order_by=updated_at&sort=asc&source=schedule&updated_after=2021-03-01T10%3A00%3A00Z&updated_before=2021-03-02T10%3A00%3A00Z")
+		fmt.Fprint(w, `[{"id":1,"project_id":1,"source":"schedule","status":"success"}]`)
+	})
+
+	after := time.Date(2021, time.March, 1, 10, 0, 0, 0, time.UTC)
+	before := after.Add(24 * time.Hour)
+	opt := &ListProjectPipelinesOptions{
+		Source:        String("schedule"),
+		Name:          String("nightly"),
+		UpdatedAfter:  &after,
+		UpdatedBefore: &before,
+		OrderBy:       String("updated_at"),
+		Sort:          String("asc"),
+	}
+	piplines, _, err := client.Pipelines.ListProjectPipelines(1, opt)
+	if err != nil {
+		t.Errorf("Pipelines.ListProjectPipelines returned error: %v", err)
+	}
+
+	want := []*PipelineInfo{{ID: 1, ProjectID: 1, Source: "schedule", Status: "success"}}
+	if !reflect.DeepEqual(want, piplines) {
+		t.Errorf("Pipelines.ListProjectPipelines returned %+v, want %+v", piplines, want)
+	}
+}
@@ -0,0 +1,162 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProjectAccessTokensService handles communication with the
+// project access tokens related methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_access_tokens.html
+type ProjectAccessTokensService struct {
+	client *Client
+}
+
+// ProjectAccessToken represents a GitLab project access token. The Token
+// field is only set in the responses of CreateProjectAccessToken and
+// RotateProjectAccessToken, it is never returned again afterwards.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/project_access_tokens.html
+type ProjectAccessToken struct {
+	ID          int              `json:"id"`
+	UserID      int              `json:"user_id"`
+	Name        string           `json:"name"`
+	Scopes      []string         `json:"scopes"`
+	CreatedAt   *time.Time       `json:"created_at"`
+	LastUsedAt  *time.Time       `json:"last_used_at"`
+	ExpiresAt   *ISOTime         `json:"expires_at"`
+	Active      bool             `json:"active"`
+	Revoked     bool             `json:"revoked"`
+	Token       string           `json:"token"`
+	AccessLevel AccessLevelValue `json:"access_level"`
+}
+
+func (v ProjectAccessToken) String() string {
+	return Stringify(v)
+}
+
+// ListProjectAccessTokensOptions represents the available
+// ListProjectAccessTokens() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens
+type ListProjectAccessTokensOptions ListOptions
+
+// ListProjectAccessTokens gets a list of all project access tokens in a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#list-project-access-tokens
+func (s *ProjectAccessTokensService) ListProjectAccessTokens(pid interface{}, opt *ListProjectAccessTokensOptions, options ...RequestOptionFunc) ([]*ProjectAccessToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/access_tokens", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pats []*ProjectAccessToken
+	resp, err := s.client.Do(req, &pats)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pats, resp, err
+}
+
+// CreateProjectAccessTokenOptions represents the available
+// CreateProjectAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#create-a-project-access-token
+type CreateProjectAccessTokenOptions struct {
+	Name        *string           `url:"name,omitempty" json:"name,omitempty"`
+	Scopes      *[]string         `url:"scopes,omitempty" json:"scopes,omitempty"`
+	AccessLevel *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+	ExpiresAt   *ISOTime          `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// CreateProjectAccessToken creates a new project access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#create-a-project-access-token
+func (s *ProjectAccessTokensService) CreateProjectAccessToken(pid interface{}, opt *CreateProjectAccessTokenOptions, options ...RequestOptionFunc) (*ProjectAccessToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/access_tokens", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(ProjectAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RotateProjectAccessTokenOptions represents the available
+// RotateProjectAccessToken() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#rotate-a-project-access-token
+type RotateProjectAccessTokenOptions struct {
+	ExpiresAt *ISOTime `url:"expires_at,omitempty" json:"expires_at,omitempty"`
+}
+
+// RotateProjectAccessToken revokes a project access token and returns a new
+// one. The new token value is only available in the returned
+// ProjectAccessToken.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#rotate-a-project-access-token
+func (s *ProjectAccessTokensService) RotateProjectAccessToken(pid interface{}, tokenID int, opt *RotateProjectAccessTokenOptions, options ...RequestOptionFunc) (*ProjectAccessToken, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/access_tokens/%d/rotate", pathEscape(project), tokenID)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pat := new(ProjectAccessToken)
+	resp, err := s.client.Do(req, pat)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pat, resp, err
+}
+
+// RevokeProjectAccessToken revokes a project access token.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_access_tokens.html#revoke-a-project-access-token
+func (s *ProjectAccessTokensService) RevokeProjectAccessToken(pid interface{}, tokenID int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/access_tokens/%d", pathEscape(project), tokenID)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,90 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListProjectAccessTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 1876, "user_id": 2453, "name": "token 10", "scopes": ["api", "read_api"], "active": true, "access_level": 40}]`)
+	})
+
+	pats, _, err := client.ProjectAccessTokens.ListProjectAccessTokens(1, &ListProjectAccessTokensOptions{Page: 1, PerPage: 20})
+	require.NoError(t, err)
+
+	want := []*ProjectAccessToken{{
+		ID:          1876,
+		UserID:      2453,
+		Name:        "token 10",
+		Scopes:      []string{"api", "read_api"},
+		Active:      true,
+		AccessLevel: MaintainerPermissions,
+	}}
+	assert.Equal(t, want, pats)
+}
+
+func TestCreateProjectAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/access_tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"ci","scopes":["api"],"access_level":30,"expires_at":"2021-01-31"}`)
+		fmt.Fprint(w, `{"id": 1876, "name": "ci", "scopes": ["api"], "expires_at": "2021-01-31", "token": "2UsevZE1x1ZdFZW4MNzH", "access_level": 30}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2021, time.January, 31, 0, 0, 0, 0, time.UTC))
+	opt := &CreateProjectAccessTokenOptions{
+		Name:        String("ci"),
+		Scopes:      &[]string{"api"},
+		AccessLevel: AccessLevel(DeveloperPermissions),
+		ExpiresAt:   &expiresAt,
+	}
+	pat, _, err := client.ProjectAccessTokens.CreateProjectAccessToken(1, opt)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2UsevZE1x1ZdFZW4MNzH", pat.Token)
+	assert.Equal(t, DeveloperPermissions, pat.AccessLevel)
+	assert.Equal(t, &expiresAt, pat.ExpiresAt)
+}
+
+func TestRotateProjectAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/access_tokens/42/rotate", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"expires_at":"2023-04-30"}`)
+		fmt.Fprint(w, `{"id": 43, "name": "ci", "active": true, "expires_at": "2023-04-30", "token": "s3cr3t"}`)
+	})
+
+	expiresAt := ISOTime(time.Date(2023, time.April, 30, 0, 0, 0, 0, time.UTC))
+	pat, _, err := client.ProjectAccessTokens.RotateProjectAccessToken(1, 42, &RotateProjectAccessTokenOptions{ExpiresAt: &expiresAt})
+	require.NoError(t, err)
+
+	want := &ProjectAccessToken{ID: 43, Name: "ci", Active: true, ExpiresAt: &expiresAt, Token: "s3cr3t"}
+	assert.Equal(t, want, pat)
+}
+
+func TestRevokeProjectAccessToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/access_tokens/42", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.ProjectAccessTokens.RevokeProjectAccessToken(1, 42)
+	require.NoError(t, err)
+}
@@ -10,12 +10,15 @@ import (
 // https://docs.gitlab.com/ee/api/project_badges.html#list-all-badges-of-a-project
 type ProjectBadge struct {
 	ID               int    `json:"id"`
+	Name             string `json:"name"`
 	LinkURL          string `json:"link_url"`
 	ImageURL         string `json:"image_url"`
 	RenderedLinkURL  string `json:"rendered_link_url"`
 	RenderedImageURL string `json:"rendered_image_url"`
-	// Kind represents a project badge kind. Can be empty, when used PreviewProjectBadge().
-	Kind string `json:"kind"`
+	// Kind is ProjectBadgeKind for badges of the project itself and
+	// GroupBadgeKind for badges inherited from its group. It is empty for
+	// badges returned by PreviewProjectBadge().
+	Kind BadgeKind `json:"kind"`
 }
 
 // ProjectBadgesService handles communication with the project badges
@@ -31,7 +34,10 @@ type ProjectBadgesService struct {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_badges.html#list-all-badges-of-a-project
-type ListProjectBadgesOptions ListOptions
+type ListProjectBadgesOptions struct {
+	ListOptions
+	Name *string `url:"name,omitempty" json:"name,omitempty"`
+}
 
 // ListProjectBadges gets a list of a project's badges and its group badges.
 //
@@ -90,6 +96,7 @@ func (s *ProjectBadgesService) GetProjectBadge(pid interface{}, badge int, optio
 type AddProjectBadgeOptions struct {
 	LinkURL  *string `url:"link_url,omitempty" json:"link_url,omitempty"`
 	ImageURL *string `url:"image_url,omitempty" json:"image_url,omitempty"`
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
 }
 
 // AddProjectBadge adds a badge to a project.
@@ -124,6 +131,7 @@ func (s *ProjectBadgesService) AddProjectBadge(pid interface{}, opt *AddProjectB
 type EditProjectBadgeOptions struct {
 	LinkURL  *string `url:"link_url,omitempty" json:"link_url,omitempty"`
 	ImageURL *string `url:"image_url,omitempty" json:"image_url,omitempty"`
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
 }
 
 // EditProjectBadge updates a badge of a project.
@@ -178,6 +186,7 @@ func (s *ProjectBadgesService) DeleteProjectBadge(pid interface{}, badge int, op
 type ProjectBadgePreviewOptions struct {
 	LinkURL  *string `url:"link_url,omitempty" json:"link_url,omitempty"`
 	ImageURL *string `url:"image_url,omitempty" json:"image_url,omitempty"`
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
 }
 
 // PreviewProjectBadge returns how the link_url and image_url final URLs would be after
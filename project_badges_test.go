@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListProjectBadges(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/badges",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			testURL(t, r, "/api/v4/projects/1/badges?name=coverage")
+			fmt.Fprint(w, `[{"id":1, "name":"coverage", "kind":"project"},{"id":2, "name":"coverage", "kind":"group"}]`)
+		})
+
+	badges, _, err := client.ProjectBadges.ListProjectBadges(1, &ListProjectBadgesOptions{Name: String("coverage")})
+	if err != nil {
+		t.Errorf("ProjectBadges.ListProjectBadges returned error: %v", err)
+	}
+
+	want := []*ProjectBadge{
+		{ID: 1, Name: "coverage", Kind: ProjectBadgeKind},
+		{ID: 2, Name: "coverage", Kind: GroupBadgeKind},
+	}
+	if !reflect.DeepEqual(want, badges) {
+		t.Errorf("ProjectBadges.ListProjectBadges returned %+v, want %+v", badges, want)
+	}
+}
+
+func TestAddProjectBadge(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/badges",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"link_url":"https://example.com/%{project_path}","image_url":"https://example.com/%{project_path}/badge.svg","name":"coverage"}`)
+			fmt.Fprint(w, `{
+				"id": 3,
+				"name": "coverage",
+				"link_url": "https://example.com/%{project_path}",
+				"image_url": "https://example.com/%{project_path}/badge.svg",
+				"rendered_link_url": "https://example.com/group/project",
+				"rendered_image_url": "https://example.com/group/project/badge.svg",
+				"kind": "project"
+			}`)
+		})
+
+	opt := &AddProjectBadgeOptions{
+		LinkURL:  String("https://example.com/%{project_path}"),
+		ImageURL: String("https://example.com/%{project_path}/badge.svg"),
+		Name:     String("coverage"),
+	}
+	badge, _, err := client.ProjectBadges.AddProjectBadge(1, opt)
+	if err != nil {
+		t.Errorf("ProjectBadges.AddProjectBadge returned error: %v", err)
+	}
+
+	want := &ProjectBadge{
+		ID:               3,
+		Name:             "coverage",
+		LinkURL:          "https://example.com/%{project_path}",
+		ImageURL:         "https://example.com/%{project_path}/badge.svg",
+		RenderedLinkURL:  "https://example.com/group/project",
+		RenderedImageURL: "https://example.com/group/project/badge.svg",
+		Kind:             ProjectBadgeKind,
+	}
+	if !reflect.DeepEqual(want, badge) {
+		t.Errorf("ProjectBadges.AddProjectBadge returned %+v, want %+v", badge, want)
+	}
+}
+
+func TestPreviewProjectBadge(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/badges/render",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "GET")
+			fmt.Fprint(w, `{"link_url": "https://example.com/%{project_path}", "rendered_link_url": "https://example.com/group/project"}`)
+		})
+
+	opt := &ProjectBadgePreviewOptions{LinkURL: String("https://example.com/%{project_path}")}
+	badge, _, err := client.ProjectBadges.PreviewProjectBadge(1, opt)
+	if err != nil {
+		t.Errorf("ProjectBadges.PreviewProjectBadge returned error: %v", err)
+	}
+
+	want := &ProjectBadge{
+		LinkURL:         "https://example.com/%{project_path}",
+		RenderedLinkURL: "https://example.com/group/project",
+	}
+	if !reflect.DeepEqual(want, badge) {
+		t.Errorf("ProjectBadges.PreviewProjectBadge returned %+v, want %+v", badge, want)
+	}
+}
@@ -0,0 +1,479 @@
+package gitlab
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FeatureFlagsService handles communication with the project feature flag
+// and feature flag user list related methods of the GitLab API. These are
+// the flags a project uses for its own code, not the instance wide
+// development flags managed by the FeaturesService.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
+type FeatureFlagsService struct {
+	client *Client
+}
+
+// FeatureFlag represents a project feature flag.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
+type FeatureFlag struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Active      bool                   `json:"active"`
+	Version     string                 `json:"version"`
+	CreatedAt   *time.Time             `json:"created_at"`
+	UpdatedAt   *time.Time             `json:"updated_at"`
+	Scopes      []*FeatureFlagScope    `json:"scopes"`
+	Strategies  []*FeatureFlagStrategy `json:"strategies"`
+}
+
+func (f FeatureFlag) String() string {
+	return Stringify(f)
+}
+
+// FeatureFlagStrategy represents a strategy of a project feature flag.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
+type FeatureFlagStrategy struct {
+	ID         int                           `json:"id"`
+	Name       FeatureFlagStrategyNameValue  `json:"name"`
+	Parameters FeatureFlagStrategyParameters `json:"parameters"`
+	Scopes     []*FeatureFlagScope           `json:"scopes"`
+	UserList   *FeatureFlagUserList          `json:"user_list"`
+}
+
+// FeatureFlagScope represents an environment scope of a feature flag
+// strategy.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flags.html
+type FeatureFlagScope struct {
+	ID               int    `json:"id"`
+	EnvironmentScope string `json:"environment_scope"`
+}
+
+// FeatureFlagStrategyParameters holds the parameters of a feature flag
+// strategy. Which keys are used depends on the strategy, GitLab sends and
+// expects all values as strings. Use the typed constructors and getters
+// below instead of dealing with the raw keys.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/operations/feature_flags.html#feature-flag-strategies
+type FeatureFlagStrategyParameters map[string]string
+
+// GradualRolloutParameters returns the parameters of a gradualRolloutUserId
+// strategy rolling out to the given percentage of users.
+func GradualRolloutParameters(percentage int, groupID string) FeatureFlagStrategyParameters {
+	return FeatureFlagStrategyParameters{
+		"groupId":    groupID,
+		"percentage": strconv.Itoa(percentage),
+	}
+}
+
+// UserWithIDParameters returns the parameters of a userWithId strategy
+// enabled for the given user IDs.
+func UserWithIDParameters(userIDs ...string) FeatureFlagStrategyParameters {
+	return FeatureFlagStrategyParameters{
+		"userIds": strings.Join(userIDs, ","),
+	}
+}
+
+// FlexibleRolloutParameters returns the parameters of a flexibleRollout
+// strategy rolling out to the given percentage, with stickiness being one of
+// "default", "userId", "sessionId" or "random".
+func FlexibleRolloutParameters(rollout int, stickiness, groupID string) FeatureFlagStrategyParameters {
+	return FeatureFlagStrategyParameters{
+		"groupId":    groupID,
+		"rollout":    strconv.Itoa(rollout),
+		"stickiness": stickiness,
+	}
+}
+
+// GroupID returns the groupId parameter.
+func (p FeatureFlagStrategyParameters) GroupID() string {
+	return p["groupId"]
+}
+
+// Percentage returns the percentage parameter of a gradualRolloutUserId
+// strategy. The boolean is false when the parameter is missing or invalid.
+func (p FeatureFlagStrategyParameters) Percentage() (int, bool) {
+	return p.intParameter("percentage")
+}
+
+// Rollout returns the rollout parameter of a flexibleRollout strategy. The
+// boolean is false when the parameter is missing or invalid.
+func (p FeatureFlagStrategyParameters) Rollout() (int, bool) {
+	return p.intParameter("rollout")
+}
+
+// Stickiness returns the stickiness parameter of a flexibleRollout strategy.
+func (p FeatureFlagStrategyParameters) Stickiness() string {
+	return p["stickiness"]
+}
+
+// UserIDs returns the user IDs of a userWithId strategy.
+func (p FeatureFlagStrategyParameters) UserIDs() []string {
+	if p["userIds"] == "" {
+		return nil
+	}
+	return strings.Split(p["userIds"], ",")
+}
+
+func (p FeatureFlagStrategyParameters) intParameter(key string) (int, bool) {
+	v, ok := p[key]
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// ListFeatureFlagsOptions represents the available ListFeatureFlags()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#list-feature-flags-for-a-project
+type ListFeatureFlagsOptions struct {
+	ListOptions
+	Scope *string `url:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// ListFeatureFlags gets all feature flags of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#list-feature-flags-for-a-project
+func (s *FeatureFlagsService) ListFeatureFlags(pid interface{}, opt *ListFeatureFlagsOptions, options ...RequestOptionFunc) ([]*FeatureFlag, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ffs []*FeatureFlag
+	resp, err := s.client.Do(req, &ffs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ffs, resp, err
+}
+
+// GetFeatureFlag gets a single feature flag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#get-a-single-feature-flag
+func (s *FeatureFlagsService) GetFeatureFlag(pid interface{}, name string, options ...RequestOptionFunc) (*FeatureFlag, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags/%s", pathEscape(project), pathEscape(name))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ff := new(FeatureFlag)
+	resp, err := s.client.Do(req, ff)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ff, resp, err
+}
+
+// FeatureFlagStrategyOptions represents a strategy when creating or updating
+// a feature flag. Set ID to update an existing strategy and Destroy to
+// remove it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#create-a-feature-flag
+type FeatureFlagStrategyOptions struct {
+	ID         *int                          `url:"id,omitempty" json:"id,omitempty"`
+	Name       *FeatureFlagStrategyNameValue `url:"name,omitempty" json:"name,omitempty"`
+	Parameters FeatureFlagStrategyParameters `url:"parameters,omitempty" json:"parameters,omitempty"`
+	UserListID *int                          `url:"user_list_id,omitempty" json:"user_list_id,omitempty"`
+	Scopes     []*FeatureFlagScopeOptions    `url:"scopes,omitempty" json:"scopes,omitempty"`
+	Destroy    *bool                         `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+// FeatureFlagScopeOptions represents an environment scope of a strategy when
+// creating or updating a feature flag. Set ID to update an existing scope
+// and Destroy to remove it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#create-a-feature-flag
+type FeatureFlagScopeOptions struct {
+	ID               *int    `url:"id,omitempty" json:"id,omitempty"`
+	EnvironmentScope *string `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	Destroy          *bool   `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+// CreateFeatureFlagOptions represents the available CreateFeatureFlag()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#create-a-feature-flag
+type CreateFeatureFlagOptions struct {
+	Name        *string                       `url:"name,omitempty" json:"name,omitempty"`
+	Version     *string                       `url:"version,omitempty" json:"version,omitempty"`
+	Description *string                       `url:"description,omitempty" json:"description,omitempty"`
+	Active      *bool                         `url:"active,omitempty" json:"active,omitempty"`
+	Strategies  []*FeatureFlagStrategyOptions `url:"strategies,omitempty" json:"strategies,omitempty"`
+}
+
+// CreateFeatureFlag creates a feature flag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#create-a-feature-flag
+func (s *FeatureFlagsService) CreateFeatureFlag(pid interface{}, opt *CreateFeatureFlagOptions, options ...RequestOptionFunc) (*FeatureFlag, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ff := new(FeatureFlag)
+	resp, err := s.client.Do(req, ff)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ff, resp, err
+}
+
+// UpdateFeatureFlagOptions represents the available UpdateFeatureFlag()
+// options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#update-a-feature-flag
+type UpdateFeatureFlagOptions struct {
+	Name        *string                       `url:"name,omitempty" json:"name,omitempty"`
+	Description *string                       `url:"description,omitempty" json:"description,omitempty"`
+	Active      *bool                         `url:"active,omitempty" json:"active,omitempty"`
+	Strategies  []*FeatureFlagStrategyOptions `url:"strategies,omitempty" json:"strategies,omitempty"`
+}
+
+// UpdateFeatureFlag updates a feature flag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#update-a-feature-flag
+func (s *FeatureFlagsService) UpdateFeatureFlag(pid interface{}, name string, opt *UpdateFeatureFlagOptions, options ...RequestOptionFunc) (*FeatureFlag, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags/%s", pathEscape(project), pathEscape(name))
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ff := new(FeatureFlag)
+	resp, err := s.client.Do(req, ff)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ff, resp, err
+}
+
+// DeleteFeatureFlag deletes a feature flag.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flags.html#delete-a-feature-flag
+func (s *FeatureFlagsService) DeleteFeatureFlag(pid interface{}, name string, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags/%s", pathEscape(project), pathEscape(name))
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// FeatureFlagUserList represents a project feature flag user list.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/feature_flag_user_lists.html
+type FeatureFlagUserList struct {
+	ID        int        `json:"id"`
+	IID       int        `json:"iid"`
+	ProjectID int        `json:"project_id"`
+	Name      string     `json:"name"`
+	UserXIDs  string     `json:"user_xids"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+// ListFeatureFlagUserListsOptions represents the available
+// ListFeatureFlagUserLists() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#list-all-feature-flag-user-lists-for-a-project
+type ListFeatureFlagUserListsOptions struct {
+	ListOptions
+	Search *string `url:"search,omitempty" json:"search,omitempty"`
+}
+
+// ListFeatureFlagUserLists gets all feature flag user lists of a project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#list-all-feature-flag-user-lists-for-a-project
+func (s *FeatureFlagsService) ListFeatureFlagUserLists(pid interface{}, opt *ListFeatureFlagUserListsOptions, options ...RequestOptionFunc) ([]*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var uls []*FeatureFlagUserList
+	resp, err := s.client.Do(req, &uls)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return uls, resp, err
+}
+
+// GetFeatureFlagUserList gets a single feature flag user list by its IID.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#get-a-feature-flag-user-list
+func (s *FeatureFlagsService) GetFeatureFlagUserList(pid interface{}, list int, options ...RequestOptionFunc) (*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists/%d", pathEscape(project), list)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ul := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, ul)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ul, resp, err
+}
+
+// CreateFeatureFlagUserListOptions represents the available
+// CreateFeatureFlagUserList() options. UserXIDs is a comma separated list of
+// external user IDs.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#create-a-feature-flag-user-list
+type CreateFeatureFlagUserListOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	UserXIDs *string `url:"user_xids,omitempty" json:"user_xids,omitempty"`
+}
+
+// CreateFeatureFlagUserList creates a feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#create-a-feature-flag-user-list
+func (s *FeatureFlagsService) CreateFeatureFlagUserList(pid interface{}, opt *CreateFeatureFlagUserListOptions, options ...RequestOptionFunc) (*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ul := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, ul)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ul, resp, err
+}
+
+// UpdateFeatureFlagUserListOptions represents the available
+// UpdateFeatureFlagUserList() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#update-a-feature-flag-user-list
+type UpdateFeatureFlagUserListOptions struct {
+	Name     *string `url:"name,omitempty" json:"name,omitempty"`
+	UserXIDs *string `url:"user_xids,omitempty" json:"user_xids,omitempty"`
+}
+
+// UpdateFeatureFlagUserList updates a feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#update-a-feature-flag-user-list
+func (s *FeatureFlagsService) UpdateFeatureFlagUserList(pid interface{}, list int, opt *UpdateFeatureFlagUserListOptions, options ...RequestOptionFunc) (*FeatureFlagUserList, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists/%d", pathEscape(project), list)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ul := new(FeatureFlagUserList)
+	resp, err := s.client.Do(req, ul)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ul, resp, err
+}
+
+// DeleteFeatureFlagUserList deletes a feature flag user list.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/feature_flag_user_lists.html#delete-feature-flag-user-list
+func (s *FeatureFlagsService) DeleteFeatureFlagUserList(pid interface{}, list int, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/feature_flags_user_lists/%d", pathEscape(project), list)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
@@ -0,0 +1,178 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFeatureFlag(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags/awesome_feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"name": "awesome_feature",
+			"description": null,
+			"active": true,
+			"version": "new_version_flag",
+			"scopes": [],
+			"strategies": [
+				{
+					"id": 36,
+					"name": "default",
+					"parameters": {},
+					"scopes": [{"id": 37, "environment_scope": "production"}]
+				},
+				{
+					"id": 38,
+					"name": "flexibleRollout",
+					"parameters": {"groupId": "default", "rollout": "50", "stickiness": "userId"},
+					"scopes": [{"id": 39, "environment_scope": "staging"}]
+				},
+				{
+					"id": 40,
+					"name": "gitlabUserList",
+					"parameters": {},
+					"scopes": [],
+					"user_list": {"id": 1, "iid": 1, "name": "beta", "user_xids": "1,2"}
+				}
+			]
+		}`)
+	})
+
+	flag, _, err := client.FeatureFlags.GetFeatureFlag(1, "awesome_feature")
+	require.NoError(t, err)
+
+	want := &FeatureFlag{
+		Name:    "awesome_feature",
+		Active:  true,
+		Version: "new_version_flag",
+		Scopes:  []*FeatureFlagScope{},
+		Strategies: []*FeatureFlagStrategy{
+			{
+				ID:         36,
+				Name:       DefaultFeatureFlagStrategy,
+				Parameters: FeatureFlagStrategyParameters{},
+				Scopes:     []*FeatureFlagScope{{ID: 37, EnvironmentScope: "production"}},
+			},
+			{
+				ID:         38,
+				Name:       FlexibleRolloutFeatureFlagStrategy,
+				Parameters: FlexibleRolloutParameters(50, "userId", "default"),
+				Scopes:     []*FeatureFlagScope{{ID: 39, EnvironmentScope: "staging"}},
+			},
+			{
+				ID:         40,
+				Name:       GitlabUserListFeatureFlagStrategy,
+				Parameters: FeatureFlagStrategyParameters{},
+				Scopes:     []*FeatureFlagScope{},
+				UserList:   &FeatureFlagUserList{ID: 1, IID: 1, Name: "beta", UserXIDs: "1,2"},
+			},
+		},
+	}
+	assert.Equal(t, want, flag)
+
+	rollout, ok := flag.Strategies[1].Parameters.Rollout()
+	assert.True(t, ok)
+	assert.Equal(t, 50, rollout)
+	assert.Equal(t, "userId", flag.Strategies[1].Parameters.Stickiness())
+}
+
+func TestCreateFeatureFlag(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"awesome_feature","version":"new_version_flag","strategies":[{"name":"userWithId","parameters":{"userIds":"1,2"},"scopes":[{"environment_scope":"*"}]}]}`)
+		fmt.Fprint(w, `{"name": "awesome_feature", "active": true, "version": "new_version_flag", "strategies": [{"id": 1, "name": "userWithId", "parameters": {"userIds": "1,2"}}]}`)
+	})
+
+	opt := &CreateFeatureFlagOptions{
+		Name:    String("awesome_feature"),
+		Version: String("new_version_flag"),
+		Strategies: []*FeatureFlagStrategyOptions{{
+			Name:       FeatureFlagStrategyName(UserWithIDFeatureFlagStrategy),
+			Parameters: UserWithIDParameters("1", "2"),
+			Scopes:     []*FeatureFlagScopeOptions{{EnvironmentScope: String("*")}},
+		}},
+	}
+	flag, _, err := client.FeatureFlags.CreateFeatureFlag(1, opt)
+	require.NoError(t, err)
+
+	require.Len(t, flag.Strategies, 1)
+	assert.Equal(t, []string{"1", "2"}, flag.Strategies[0].Parameters.UserIDs())
+}
+
+func TestDeleteFeatureFlag(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags/awesome_feature", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.FeatureFlags.DeleteFeatureFlag(1, "awesome_feature")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestFeatureFlagStrategyParameters(t *testing.T) {
+	p := GradualRolloutParameters(25, "default")
+	percentage, ok := p.Percentage()
+	assert.True(t, ok)
+	assert.Equal(t, 25, percentage)
+	assert.Equal(t, "default", p.GroupID())
+
+	_, ok = p.Rollout()
+	assert.False(t, ok)
+
+	_, ok = FeatureFlagStrategyParameters{"percentage": "lots"}.Percentage()
+	assert.False(t, ok)
+
+	assert.Nil(t, FeatureFlagStrategyParameters{}.UserIDs())
+}
+
+func TestCreateFeatureFlagUserList(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"beta_users","user_xids":"user1,user2"}`)
+		fmt.Fprint(w, `{"id": 1, "iid": 1, "project_id": 1, "name": "beta_users", "user_xids": "user1,user2"}`)
+	})
+
+	opt := &CreateFeatureFlagUserListOptions{
+		Name:     String("beta_users"),
+		UserXIDs: String("user1,user2"),
+	}
+	list, _, err := client.FeatureFlags.CreateFeatureFlagUserList(1, opt)
+	require.NoError(t, err)
+
+	want := &FeatureFlagUserList{ID: 1, IID: 1, ProjectID: 1, Name: "beta_users", UserXIDs: "user1,user2"}
+	assert.Equal(t, want, list)
+}
+
+func TestListFeatureFlagUserLists(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/feature_flags_user_lists", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/feature_flags_user_lists?search=beta")
+		fmt.Fprint(w, `[{"id": 1, "iid": 1, "project_id": 1, "name": "beta_users", "user_xids": "user1"}]`)
+	})
+
+	lists, _, err := client.FeatureFlags.ListFeatureFlagUserLists(1, &ListFeatureFlagUserListsOptions{Search: String("beta")})
+	require.NoError(t, err)
+
+	want := []*FeatureFlagUserList{{ID: 1, IID: 1, ProjectID: 1, Name: "beta_users", UserXIDs: "user1"}}
+	assert.Equal(t, want, lists)
+}
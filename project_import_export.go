@@ -2,10 +2,22 @@ package gitlab
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"time"
 )
 
+var (
+	// ErrNoExportScheduled is returned by WaitForExport when no export was
+	// scheduled for the project, so there is nothing to wait for.
+	ErrNoExportScheduled = errors.New("No export was scheduled for the project")
+
+	// ErrNoImportScheduled is returned by WaitForImport when the project was
+	// not created by an import, so there is nothing to wait for.
+	ErrNoImportScheduled = errors.New("No import was scheduled for the project")
+)
+
 // ProjectImportExportService handles communication with the project
 // import/export related methods of the GitLab API.
 //
@@ -28,6 +40,7 @@ type ImportStatus struct {
 	PathWithNamespace string     `json:"path_with_namespace"`
 	CreateAt          *time.Time `json:"create_at"`
 	ImportStatus      string     `json:"import_status"`
+	ImportError       string     `json:"import_error"`
 }
 
 func (s ImportStatus) String() string {
@@ -194,3 +207,80 @@ func (s *ProjectImportExportService) ImportStatus(pid interface{}, options ...Re
 
 	return is, resp, err
 }
+
+// WaitForExport polls the export status of a project with the given interval
+// until the export is either finished or failed, and returns the final
+// status. A failed export is returned as an error containing the message
+// reported by GitLab. If no export was scheduled ErrNoExportScheduled is
+// returned. Polling stops when ctx is done.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#export-status
+func (s *ProjectImportExportService) WaitForExport(ctx context.Context, pid interface{}, interval time.Duration, options ...RequestOptionFunc) (*ExportStatus, *Response, error) {
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+
+	for {
+		es, resp, err := s.ExportStatus(pid, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		switch es.ExportStatus {
+		case "none":
+			return es, resp, ErrNoExportScheduled
+		case "finished":
+			return es, resp, nil
+		case "failed":
+			return es, resp, fmt.Errorf("export of project %s failed: %s", es.PathWithNamespace, es.Message)
+		}
+
+		if err := waitForInterval(ctx, interval); err != nil {
+			return es, resp, err
+		}
+	}
+}
+
+// WaitForImport polls the import status of a project with the given interval
+// until the import is either finished or failed, and returns the final
+// status. A failed import is returned as an error containing the error
+// reported by GitLab. If the project was not imported ErrNoImportScheduled is
+// returned. Polling stops when ctx is done.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_import_export.html#import-status
+func (s *ProjectImportExportService) WaitForImport(ctx context.Context, pid interface{}, interval time.Duration, options ...RequestOptionFunc) (*ImportStatus, *Response, error) {
+	options = append(options[:len(options):len(options)], WithContext(ctx))
+
+	for {
+		is, resp, err := s.ImportStatus(pid, options...)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		switch is.ImportStatus {
+		case "none":
+			return is, resp, ErrNoImportScheduled
+		case "finished":
+			return is, resp, nil
+		case "failed":
+			return is, resp, fmt.Errorf("import of project %s failed: %s", is.PathWithNamespace, is.ImportError)
+		}
+
+		if err := waitForInterval(ctx, interval); err != nil {
+			return is, resp, err
+		}
+	}
+}
+
+// waitForInterval blocks for the given interval or until ctx is done.
+func waitForInterval(ctx context.Context, interval time.Duration) error {
+	t := time.NewTimer(interval)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,106 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestWaitForExport(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	polls := 0
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		polls++
+		status := "started"
+		if polls == 3 {
+			status = "finished"
+		}
+		fmt.Fprintf(w, `{"id": 1, "path_with_namespace": "gitlab-org/gitlab-test", "export_status": %q}`, status)
+	})
+
+	es, _, err := client.ProjectImportExport.WaitForExport(context.Background(), 1, time.Millisecond)
+	if err != nil {
+		t.Fatalf("ProjectImportExport.WaitForExport returned error: %v", err)
+	}
+	if es.ExportStatus != "finished" {
+		t.Errorf("ProjectImportExport.WaitForExport returned status %q, want finished", es.ExportStatus)
+	}
+	if polls != 3 {
+		t.Errorf("ProjectImportExport.WaitForExport polled %d times, want 3", polls)
+	}
+}
+
+func TestWaitForExportNoneScheduled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "export_status": "none"}`)
+	})
+
+	_, _, err := client.ProjectImportExport.WaitForExport(context.Background(), 1, time.Hour)
+	if err != ErrNoExportScheduled {
+		t.Errorf("ProjectImportExport.WaitForExport returned error %v, want %v", err, ErrNoExportScheduled)
+	}
+}
+
+func TestWaitForImportNoneScheduled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "import_status": "none"}`)
+	})
+
+	_, _, err := client.ProjectImportExport.WaitForImport(context.Background(), 1, time.Hour)
+	if err != ErrNoImportScheduled {
+		t.Errorf("ProjectImportExport.WaitForImport returned error %v, want %v", err, ErrNoImportScheduled)
+	}
+}
+
+func TestWaitForExportContextCanceled(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mux.HandleFunc("/api/v4/projects/1/export", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		fmt.Fprint(w, `{"id": 1, "export_status": "queued"}`)
+	})
+
+	_, _, err := client.ProjectImportExport.WaitForExport(ctx, 1, time.Hour)
+	if err != context.Canceled {
+		t.Errorf("ProjectImportExport.WaitForExport returned error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestWaitForImportFailed(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/import", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "path_with_namespace": "gitlab-org/gitlab-test", "import_status": "failed", "import_error": "Invalid archive"}`)
+	})
+
+	is, _, err := client.ProjectImportExport.WaitForImport(context.Background(), 1, time.Millisecond)
+	if err == nil {
+		t.Fatal("ProjectImportExport.WaitForImport returned no error for a failed import")
+	}
+
+	want := "import of project gitlab-org/gitlab-test failed: Invalid archive"
+	if err.Error() != want {
+		t.Errorf("ProjectImportExport.WaitForImport returned error %q, want %q", err, want)
+	}
+	if is.ImportStatus != "failed" {
+		t.Errorf("ProjectImportExport.WaitForImport returned status %q, want failed", is.ImportStatus)
+	}
+}
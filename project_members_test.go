@@ -0,0 +1,85 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAllProjectMembers(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/members/all?query=raymond")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"username": "raymond_smith",
+			"access_level": 30,
+			"group_saml_identity": {"extern_uid": "ABC-1234567890", "provider": "group_saml", "saml_provider_id": 10}
+		}]`)
+	})
+
+	members, _, err := client.ProjectMembers.ListAllProjectMembers(1, &ListProjectMembersOptions{Query: String("raymond")})
+	require.NoError(t, err)
+
+	want := []*ProjectMember{{
+		ID:          1,
+		Username:    "raymond_smith",
+		AccessLevel: DeveloperPermissions,
+		GroupSAMLIdentity: &GroupMemberSAMLIdentity{
+			ExternUID:      "ABC-1234567890",
+			Provider:       "group_saml",
+			SAMLProviderID: 10,
+		},
+	}}
+	assert.Equal(t, want, members)
+}
+
+func TestGetInheritedProjectMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/members/all/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "username": "john_doe", "access_level": 50}`)
+	})
+
+	member, _, err := client.ProjectMembers.GetInheritedProjectMember(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectMember{ID: 2, Username: "john_doe", AccessLevel: OwnerPermissions}, member)
+}
+
+func TestListProjectMembersByState(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/members?state=awaiting")
+		fmt.Fprint(w, `[{"id": 3, "username": "pending", "state": "awaiting"}]`)
+	})
+
+	members, _, err := client.ProjectMembers.ListProjectMembers(1, &ListProjectMembersOptions{State: String("awaiting")})
+	require.NoError(t, err)
+	assert.Equal(t, []*ProjectMember{{ID: 3, Username: "pending", State: "awaiting"}}, members)
+}
+
+func TestEditProjectMember(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/members/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"access_level":20,"expires_at":null}`)
+		fmt.Fprint(w, `{"id": 2, "access_level": 20, "expires_at": null}`)
+	})
+
+	member, _, err := client.ProjectMembers.EditProjectMember(1, 2, &EditProjectMemberOptions{AccessLevel: AccessLevel(ReporterPermissions)})
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectMember{ID: 2, AccessLevel: ReporterPermissions}, member)
+}
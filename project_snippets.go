@@ -19,6 +19,7 @@ package gitlab
 import (
 	"bytes"
 	"fmt"
+	"io"
 )
 
 // ProjectSnippetsService handles communication with the project snippets
@@ -88,11 +89,12 @@ func (s *ProjectSnippetsService) GetSnippet(pid interface{}, snippet int, option
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/project_snippets.html#create-new-snippet
 type CreateProjectSnippetOptions struct {
-	Title       *string          `url:"title,omitempty" json:"title,omitempty"`
-	FileName    *string          `url:"file_name,omitempty" json:"file_name,omitempty"`
-	Description *string          `url:"description,omitempty" json:"description,omitempty"`
-	Content     *string          `url:"content,omitempty" json:"content,omitempty"`
-	Visibility  *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Title       *string                     `url:"title,omitempty" json:"title,omitempty"`
+	FileName    *string                     `url:"file_name,omitempty" json:"file_name,omitempty"`
+	Description *string                     `url:"description,omitempty" json:"description,omitempty"`
+	Content     *string                     `url:"content,omitempty" json:"content,omitempty"`
+	Visibility  *VisibilityValue            `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Files       []*CreateSnippetFileOptions `url:"files,omitempty" json:"files,omitempty"`
 }
 
 // CreateSnippet creates a new project snippet. The user must have permission
@@ -126,11 +128,12 @@ func (s *ProjectSnippetsService) CreateSnippet(pid interface{}, opt *CreateProje
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/project_snippets.html#update-snippet
 type UpdateProjectSnippetOptions struct {
-	Title       *string          `url:"title,omitempty" json:"title,omitempty"`
-	FileName    *string          `url:"file_name,omitempty" json:"file_name,omitempty"`
-	Description *string          `url:"description,omitempty" json:"description,omitempty"`
-	Content     *string          `url:"content,omitempty" json:"content,omitempty"`
-	Visibility  *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Title       *string                     `url:"title,omitempty" json:"title,omitempty"`
+	FileName    *string                     `url:"file_name,omitempty" json:"file_name,omitempty"`
+	Description *string                     `url:"description,omitempty" json:"description,omitempty"`
+	Content     *string                     `url:"content,omitempty" json:"content,omitempty"`
+	Visibility  *VisibilityValue            `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Files       []*UpdateSnippetFileOptions `url:"files,omitempty" json:"files,omitempty"`
 }
 
 // UpdateSnippet updates an existing project snippet. The user must have
@@ -204,3 +207,45 @@ func (s *ProjectSnippetsService) SnippetContent(pid interface{}, snippet int, op
 
 	return b.Bytes(), resp, err
 }
+
+// SnippetFileContent returns the raw content of a single file of a project
+// snippet at the given ref. The complete file is kept in memory, use
+// SnippetFileContentToWriter for large files.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_snippets.html#snippet-repository-file-content
+func (s *ProjectSnippetsService) SnippetFileContent(pid interface{}, snippet int, ref, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	var b bytes.Buffer
+	resp, err := s.SnippetFileContentToWriter(pid, snippet, ref, fileName, &b, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+// SnippetFileContentToWriter streams the raw content of a single file of a
+// project snippet at the given ref to w.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/project_snippets.html#snippet-repository-file-content
+func (s *ProjectSnippetsService) SnippetFileContentToWriter(pid interface{}, snippet int, ref, fileName string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/snippets/%d/files/%s/%s/raw",
+		pathEscape(project),
+		snippet,
+		pathEscape(ref),
+		pathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
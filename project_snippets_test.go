@@ -0,0 +1,45 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMultiFileProjectSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"snippet","files":[{"file_path":"a.rb","content":"puts 'a'"}]}`)
+		fmt.Fprint(w, `{"id": 2, "title": "snippet", "files": [{"path": "a.rb", "raw_url": "https://gitlab.example.com/p/-/snippets/2/raw/main/a.rb"}]}`)
+	})
+
+	opt := &CreateProjectSnippetOptions{
+		Title: String("snippet"),
+		Files: []*CreateSnippetFileOptions{{FilePath: String("a.rb"), Content: String("puts 'a'")}},
+	}
+	snippet, _, err := client.ProjectSnippets.CreateSnippet(1, opt)
+	require.NoError(t, err)
+
+	want := []*SnippetFile{{Path: "a.rb", RawURL: "https://gitlab.example.com/p/-/snippets/2/raw/main/a.rb"}}
+	assert.Equal(t, want, snippet.Files)
+}
+
+func TestProjectSnippetFileContent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/snippets/2/files/main/a.rb/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "puts 'a'")
+	})
+
+	content, _, err := client.ProjectSnippets.SnippetFileContent(1, 2, "main", "a.rb")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("puts 'a'"), content)
+}
@@ -0,0 +1,189 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProjectStorageMovesService handles communication with the project
+// repository storage moves related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type ProjectStorageMovesService struct {
+	client *Client
+}
+
+// ProjectRepositoryStorageMove represents the move of a project repository
+// from one storage to another.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html
+type ProjectRepositoryStorageMove struct {
+	ID                     int        `json:"id"`
+	CreatedAt              *time.Time `json:"created_at"`
+	State                  string     `json:"state"`
+	SourceStorageName      string     `json:"source_storage_name"`
+	DestinationStorageName string     `json:"destination_storage_name"`
+	Project                *Project   `json:"project"`
+}
+
+// ListProjectStorageMovesOptions represents the available
+// ListAllStorageMoves() and ListStorageMovesForProject() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-project-repository-storage-moves
+type ListProjectStorageMovesOptions ListOptions
+
+// ListAllStorageMoves retrieves all project repository storage moves
+// accessible by the authenticated administrator.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-project-repository-storage-moves
+func (s *ProjectStorageMovesService) ListAllStorageMoves(opt *ListProjectStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+	req, err := s.client.NewRequest("GET", "project_repository_storage_moves", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ms []*ProjectRepositoryStorageMove
+	resp, err := s.client.Do(req, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+// ListStorageMovesForProject retrieves all repository storage moves of a
+// single project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#retrieve-all-repository-storage-moves-for-a-project
+func (s *ProjectStorageMovesService) ListStorageMovesForProject(pid interface{}, opt *ListProjectStorageMovesOptions, options ...RequestOptionFunc) ([]*ProjectRepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves", pathEscape(project))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ms []*ProjectRepositoryStorageMove
+	resp, err := s.client.Do(req, &ms)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ms, resp, err
+}
+
+// GetStorageMove gets a single project repository storage move.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-project-repository-storage-move
+func (s *ProjectStorageMovesService) GetStorageMove(storageMove int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	u := fmt.Sprintf("project_repository_storage_moves/%d", storageMove)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(ProjectRepositoryStorageMove)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// GetStorageMoveForProject gets a single repository storage move of a
+// project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#get-a-single-repository-storage-move-for-a-project
+func (s *ProjectStorageMovesService) GetStorageMoveForProject(pid interface{}, storageMove int, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves/%d", pathEscape(project), storageMove)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(ProjectRepositoryStorageMove)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// ScheduleStorageMoveForProjectOptions represents the available
+// ScheduleStorageMoveForProject() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+type ScheduleStorageMoveForProjectOptions struct {
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleStorageMoveForProject schedules a repository storage move for a
+// project. When no destination storage is given, GitLab picks one based on
+// the storage weights.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-a-repository-storage-move-for-a-project
+func (s *ProjectStorageMovesService) ScheduleStorageMoveForProject(pid interface{}, opt *ScheduleStorageMoveForProjectOptions, options ...RequestOptionFunc) (*ProjectRepositoryStorageMove, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/repository_storage_moves", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	m := new(ProjectRepositoryStorageMove)
+	resp, err := s.client.Do(req, m)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return m, resp, err
+}
+
+// ScheduleAllStorageMovesOptions represents the available
+// ScheduleAllStorageMoves() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+type ScheduleAllStorageMovesOptions struct {
+	SourceStorageName      *string `url:"source_storage_name,omitempty" json:"source_storage_name,omitempty"`
+	DestinationStorageName *string `url:"destination_storage_name,omitempty" json:"destination_storage_name,omitempty"`
+}
+
+// ScheduleAllStorageMoves schedules repository storage moves for all projects
+// on a storage shard.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_repository_storage_moves.html#schedule-repository-storage-moves-for-all-projects-on-a-storage-shard
+func (s *ProjectStorageMovesService) ScheduleAllStorageMoves(opt *ScheduleAllStorageMovesOptions, options ...RequestOptionFunc) (*Response, error) {
+	req, err := s.client.NewRequest("POST", "project_repository_storage_moves", opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
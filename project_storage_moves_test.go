@@ -0,0 +1,89 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListAllStorageMoves(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/project_repository_storage_moves?page=1&per_page=2")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"created_at": "2020-05-07T04:27:17.234Z",
+			"state": "scheduled",
+			"source_storage_name": "default",
+			"destination_storage_name": "storage2",
+			"project": {"id": 1, "path_with_namespace": "gitlab-org/gitlab"}
+		}]`)
+	})
+
+	moves, _, err := client.ProjectStorageMoves.ListAllStorageMoves(&ListProjectStorageMovesOptions{Page: 1, PerPage: 2})
+	require.NoError(t, err)
+
+	createdAt := time.Date(2020, time.May, 7, 4, 27, 17, 234000000, time.UTC)
+	want := []*ProjectRepositoryStorageMove{{
+		ID:                     1,
+		CreatedAt:              &createdAt,
+		State:                  "scheduled",
+		SourceStorageName:      "default",
+		DestinationStorageName: "storage2",
+		Project:                &Project{ID: 1, PathWithNamespace: "gitlab-org/gitlab"},
+	}}
+	assert.Equal(t, want, moves)
+}
+
+func TestGetStorageMoveForProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves/2", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 2, "state": "finished"}`)
+	})
+
+	move, _, err := client.ProjectStorageMoves.GetStorageMoveForProject(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectRepositoryStorageMove{ID: 2, State: "finished"}, move)
+}
+
+func TestScheduleStorageMoveForProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"destination_storage_name":"storage2"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 3, "state": "scheduled", "destination_storage_name": "storage2"}`)
+	})
+
+	opt := &ScheduleStorageMoveForProjectOptions{DestinationStorageName: String("storage2")}
+	move, _, err := client.ProjectStorageMoves.ScheduleStorageMoveForProject(1, opt)
+	require.NoError(t, err)
+	assert.Equal(t, &ProjectRepositoryStorageMove{ID: 3, State: "scheduled", DestinationStorageName: "storage2"}, move)
+}
+
+func TestScheduleAllStorageMoves(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/project_repository_storage_moves", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"source_storage_name":"default"}`)
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"message":"202 Accepted"}`)
+	})
+
+	_, err := client.ProjectStorageMoves.ScheduleAllStorageMoves(&ScheduleAllStorageMovesOptions{SourceStorageName: String("default")})
+	require.NoError(t, err)
+}
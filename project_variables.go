@@ -17,8 +17,11 @@
 package gitlab
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+
+	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
 
 // ProjectVariablesService handles communication with the
@@ -40,6 +43,7 @@ type ProjectVariable struct {
 	VariableType     VariableTypeValue `json:"variable_type"`
 	Protected        bool              `json:"protected"`
 	Masked           bool              `json:"masked"`
+	Raw              bool              `json:"raw"`
 	EnvironmentScope string            `json:"environment_scope"`
 }
 
@@ -79,7 +83,70 @@ func (s *ProjectVariablesService) ListVariables(pid interface{}, opt *ListProjec
 	return vs, resp, err
 }
 
-// GetVariable gets a variable.
+// ListAllProjectVariablesOptions represents the available ListAllVariables()
+// options. GitLab does not filter variables by environment scope, so the
+// EnvironmentScope filter is applied by the client.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#list-project-variables
+type ListAllProjectVariablesOptions struct {
+	EnvironmentScope *string `url:"-" json:"-"`
+}
+
+// ListAllVariables gets all variables of a project, fetching every page of
+// results using Scan. The returned Response is the one of the last page.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#list-project-variables
+func (s *ProjectVariablesService) ListAllVariables(pid interface{}, opt *ListAllProjectVariablesOptions, options ...RequestOptionFunc) ([]*ProjectVariable, *Response, error) {
+	var last *Response
+	items, errs := Scan(context.Background(), func(lo ListOptions, opts ...RequestOptionFunc) ([]*ProjectVariable, *Response, error) {
+		lo.PerPage = 100
+		vs, resp, err := s.ListVariables(pid, (*ListProjectVariablesOptions)(&lo), append(options, opts...)...)
+		last = resp
+		return vs, resp, err
+	})
+
+	var all []*ProjectVariable
+	for v := range items {
+		if opt == nil || opt.EnvironmentScope == nil || v.EnvironmentScope == *opt.EnvironmentScope {
+			all = append(all, v)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, last, err
+	}
+
+	return all, last, nil
+}
+
+// VariableFilter represents the filter used to select a project variable,
+// when multiple variables with the same key exist in different environment
+// scopes.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#the-filter-parameter
+type VariableFilter struct {
+	EnvironmentScope string `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+}
+
+// WithEnvironmentScopeFilter selects the variable with the given environment
+// scope, when multiple variables with the same key exist in different
+// environment scopes. It can be passed to GetVariable and RemoveVariable.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/project_level_variables.html#the-filter-parameter
+func WithEnvironmentScopeFilter(scope string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		q := req.URL.Query()
+		q.Set("filter[environment_scope]", scope)
+		req.URL.RawQuery = q.Encode()
+		return nil
+	}
+}
+
+// GetVariable gets a variable. Use WithEnvironmentScopeFilter to select the
+// variable of a specific environment scope.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#show-variable-details
@@ -115,6 +182,7 @@ type CreateProjectVariableOptions struct {
 	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 	Protected        *bool              `url:"protected,omitempty" json:"protected,omitempty"`
 	Masked           *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw              *bool              `url:"raw,omitempty" json:"raw,omitempty"`
 	EnvironmentScope *string            `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
 }
 
@@ -153,7 +221,9 @@ type UpdateProjectVariableOptions struct {
 	VariableType     *VariableTypeValue `url:"variable_type,omitempty" json:"variable_type,omitempty"`
 	Protected        *bool              `url:"protected,omitempty" json:"protected,omitempty"`
 	Masked           *bool              `url:"masked,omitempty" json:"masked,omitempty"`
+	Raw              *bool              `url:"raw,omitempty" json:"raw,omitempty"`
 	EnvironmentScope *string            `url:"environment_scope,omitempty" json:"environment_scope,omitempty"`
+	Filter           *VariableFilter    `url:"filter,omitempty" json:"filter,omitempty"`
 }
 
 // UpdateVariable updates a project's variable.
@@ -181,7 +251,8 @@ func (s *ProjectVariablesService) UpdateVariable(pid interface{}, key string, op
 	return v, resp, err
 }
 
-// RemoveVariable removes a project's variable.
+// RemoveVariable removes a project's variable. Use WithEnvironmentScopeFilter
+// to select the variable of a specific environment scope.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/project_level_variables.html#remove-variable
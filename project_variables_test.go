@@ -0,0 +1,141 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scopedVariables contains two variables with the same key, but a different
+// environment scope.
+var scopedVariables = map[string]string{
+	"production": `{"key": "DB_URL", "value": "postgres://production", "variable_type": "env_var", "environment_scope": "production"}`,
+	"staging":    `{"key": "DB_URL", "value": "postgres://staging", "variable_type": "env_var", "environment_scope": "staging"}`,
+}
+
+func TestCreateProjectVariable(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"DB_URL","value":"postgres://staging","raw":true,"environment_scope":"staging"}`)
+		fmt.Fprint(w, `{"key": "DB_URL", "value": "postgres://staging", "variable_type": "env_var", "raw": true, "environment_scope": "staging"}`)
+	})
+
+	opt := &CreateProjectVariableOptions{
+		Key:              String("DB_URL"),
+		Value:            String("postgres://staging"),
+		Raw:              Bool(true),
+		EnvironmentScope: String("staging"),
+	}
+	variable, _, err := client.ProjectVariables.CreateVariable(1, opt)
+	require.NoError(t, err)
+
+	want := &ProjectVariable{
+		Key:              "DB_URL",
+		Value:            "postgres://staging",
+		VariableType:     EnvVariableType,
+		Raw:              true,
+		EnvironmentScope: "staging",
+	}
+	assert.Equal(t, want, variable)
+}
+
+func TestGetProjectVariableWithEnvironmentScope(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/DB_URL", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		v, ok := scopedVariables[r.URL.Query().Get("filter[environment_scope]")]
+		if !ok {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+		fmt.Fprint(w, v)
+	})
+
+	for _, scope := range []string{"production", "staging"} {
+		variable, _, err := client.ProjectVariables.GetVariable(1, "DB_URL", WithEnvironmentScopeFilter(scope))
+		require.NoError(t, err)
+
+		assert.Equal(t, "DB_URL", variable.Key)
+		assert.Equal(t, scope, variable.EnvironmentScope)
+		assert.Equal(t, "postgres://"+scope, variable.Value)
+	}
+}
+
+func TestUpdateProjectVariableWithEnvironmentScope(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/DB_URL", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"value":"postgres://new-staging","filter":{"environment_scope":"staging"}}`)
+		fmt.Fprint(w, `{"key": "DB_URL", "value": "postgres://new-staging", "variable_type": "env_var", "environment_scope": "staging"}`)
+	})
+
+	opt := &UpdateProjectVariableOptions{
+		Value:  String("postgres://new-staging"),
+		Filter: &VariableFilter{EnvironmentScope: "staging"},
+	}
+	variable, _, err := client.ProjectVariables.UpdateVariable(1, "DB_URL", opt)
+	require.NoError(t, err)
+
+	want := &ProjectVariable{
+		Key:              "DB_URL",
+		Value:            "postgres://new-staging",
+		VariableType:     EnvVariableType,
+		EnvironmentScope: "staging",
+	}
+	assert.Equal(t, want, variable)
+}
+
+func TestRemoveProjectVariableWithEnvironmentScope(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables/DB_URL", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/1/variables/DB_URL?filter%5Benvironment_scope%5D=production")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.ProjectVariables.RemoveVariable(1, "DB_URL", WithEnvironmentScopeFilter("production"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestListAllProjectVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/variables", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page") {
+		case "":
+			testURL(t, r, "/api/v4/projects/1/variables?per_page=100")
+			w.Header().Set("X-Next-Page", "2")
+			fmt.Fprint(w, `[
+				{"key": "DB_PASSWORD", "value": "secret", "protected": true, "masked": false, "environment_scope": "production"},
+				{"key": "DB_PASSWORD", "value": "secret", "protected": false, "masked": true, "environment_scope": "staging"}
+			]`)
+		case "2":
+			fmt.Fprint(w, `[{"key": "API_TOKEN", "value": "token", "protected": true, "masked": true, "environment_scope": "production"}]`)
+		}
+	})
+
+	opt := &ListAllProjectVariablesOptions{EnvironmentScope: String("production")}
+	variables, _, err := client.ProjectVariables.ListAllVariables(1, opt)
+	require.NoError(t, err)
+
+	want := []*ProjectVariable{
+		{Key: "DB_PASSWORD", Value: "secret", Protected: true, Masked: false, EnvironmentScope: "production"},
+		{Key: "API_TOKEN", Value: "token", Protected: true, Masked: true, EnvironmentScope: "production"},
+	}
+	assert.Equal(t, want, variables)
+}
@@ -18,10 +18,12 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"mime/multipart"
+	"net/http"
 	"os"
 	"time"
 )
@@ -140,10 +142,15 @@ type ProjectNamespace struct {
 
 // StorageStatistics represents a statistics record for a group or project.
 type StorageStatistics struct {
-	StorageSize      int64 `json:"storage_size"`
-	RepositorySize   int64 `json:"repository_size"`
-	LfsObjectsSize   int64 `json:"lfs_objects_size"`
-	JobArtifactsSize int64 `json:"job_artifacts_size"`
+	StorageSize           int64 `json:"storage_size"`
+	RepositorySize        int64 `json:"repository_size"`
+	WikiSize              int64 `json:"wiki_size"`
+	LfsObjectsSize        int64 `json:"lfs_objects_size"`
+	JobArtifactsSize      int64 `json:"job_artifacts_size"`
+	PipelineArtifactsSize int64 `json:"pipeline_artifacts_size"`
+	PackagesSize          int64 `json:"packages_size"`
+	SnippetsSize          int64 `json:"snippets_size"`
+	UploadsSize           int64 `json:"uploads_size"`
 }
 
 // ProjectStatistics represents a statistics record for a project.
@@ -396,6 +403,21 @@ func (s *ProjectsService) GetProject(pid interface{}, opt *GetProjectOptions, op
 	return p, resp, err
 }
 
+// GetProjectStatistics gets the storage statistics of a project. It is a
+// shortcut for GetProject with the statistics option set, which requires at
+// least the Reporter role.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/projects.html#get-single-project
+func (s *ProjectsService) GetProjectStatistics(pid interface{}, options ...RequestOptionFunc) (*ProjectStatistics, *Response, error) {
+	p, resp, err := s.GetProject(pid, &GetProjectOptions{Statistics: Bool(true)}, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p.Statistics, resp, err
+}
+
 // ProjectEvent represents a GitLab project event.
 //
 // GitLab API docs:
@@ -646,13 +668,18 @@ func (s *ProjectsService) EditProject(pid interface{}, opt *EditProjectOptions,
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#fork-project
 type ForkProjectOptions struct {
-	Namespace *string `url:"namespace,omitempty" json:"namespace,omitempty"`
-	Name      *string `url:"name,omitempty" json:"name,omitempty" `
-	Path      *string `url:"path,omitempty" json:"path,omitempty"`
+	Namespace     *string          `url:"namespace,omitempty" json:"namespace,omitempty"`
+	Name          *string          `url:"name,omitempty" json:"name,omitempty" `
+	Path          *string          `url:"path,omitempty" json:"path,omitempty"`
+	NamespaceID   *int             `url:"namespace_id,omitempty" json:"namespace_id,omitempty"`
+	NamespacePath *string          `url:"namespace_path,omitempty" json:"namespace_path,omitempty"`
+	Description   *string          `url:"description,omitempty" json:"description,omitempty"`
+	Visibility    *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
 }
 
 // ForkProject forks a project into the user namespace of the authenticated
-// user.
+// user, or into the namespace given by NamespaceID or NamespacePath. The
+// Namespace option is deprecated in favor of these two.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#fork-project
 func (s *ProjectsService) ForkProject(pid interface{}, opt *ForkProjectOptions, options ...RequestOptionFunc) (*Project, *Response, error) {
@@ -847,16 +874,17 @@ func (s *ProjectsService) DeleteSharedProjectFromGroup(pid interface{}, groupID
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#list-project-team-members
 type ProjectMember struct {
-	ID          int              `json:"id"`
-	Username    string           `json:"username"`
-	Email       string           `json:"email"`
-	Name        string           `json:"name"`
-	State       string           `json:"state"`
-	CreatedAt   *time.Time       `json:"created_at"`
-	ExpiresAt   *ISOTime         `json:"expires_at"`
-	AccessLevel AccessLevelValue `json:"access_level"`
-	WebURL      string           `json:"web_url"`
-	AvatarURL   string           `json:"avatar_url"`
+	ID                int                      `json:"id"`
+	Username          string                   `json:"username"`
+	Email             string                   `json:"email"`
+	Name              string                   `json:"name"`
+	State             string                   `json:"state"`
+	CreatedAt         *time.Time               `json:"created_at"`
+	ExpiresAt         *ISOTime                 `json:"expires_at"`
+	AccessLevel       AccessLevelValue         `json:"access_level"`
+	WebURL            string                   `json:"web_url"`
+	AvatarURL         string                   `json:"avatar_url"`
+	GroupSAMLIdentity *GroupMemberSAMLIdentity `json:"group_saml_identity"`
 }
 
 // ProjectHook represents a project hook.
@@ -864,22 +892,26 @@ type ProjectMember struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#list-project-hooks
 type ProjectHook struct {
-	ID                       int        `json:"id"`
-	URL                      string     `json:"url"`
-	ConfidentialNoteEvents   bool       `json:"confidential_note_events"`
-	ProjectID                int        `json:"project_id"`
-	PushEvents               bool       `json:"push_events"`
-	PushEventsBranchFilter   string     `json:"push_events_branch_filter"`
-	IssuesEvents             bool       `json:"issues_events"`
-	ConfidentialIssuesEvents bool       `json:"confidential_issues_events"`
-	MergeRequestsEvents      bool       `json:"merge_requests_events"`
-	TagPushEvents            bool       `json:"tag_push_events"`
-	NoteEvents               bool       `json:"note_events"`
-	JobEvents                bool       `json:"job_events"`
-	PipelineEvents           bool       `json:"pipeline_events"`
-	WikiPageEvents           bool       `json:"wiki_page_events"`
-	EnableSSLVerification    bool       `json:"enable_ssl_verification"`
-	CreatedAt                *time.Time `json:"created_at"`
+	ID                        int        `json:"id"`
+	URL                       string     `json:"url"`
+	ConfidentialNoteEvents    bool       `json:"confidential_note_events"`
+	ProjectID                 int        `json:"project_id"`
+	PushEvents                bool       `json:"push_events"`
+	PushEventsBranchFilter    string     `json:"push_events_branch_filter"`
+	IssuesEvents              bool       `json:"issues_events"`
+	ConfidentialIssuesEvents  bool       `json:"confidential_issues_events"`
+	MergeRequestsEvents       bool       `json:"merge_requests_events"`
+	TagPushEvents             bool       `json:"tag_push_events"`
+	NoteEvents                bool       `json:"note_events"`
+	JobEvents                 bool       `json:"job_events"`
+	PipelineEvents            bool       `json:"pipeline_events"`
+	WikiPageEvents            bool       `json:"wiki_page_events"`
+	DeploymentEvents          bool       `json:"deployment_events"`
+	ReleasesEvents            bool       `json:"releases_events"`
+	ResourceAccessTokenEvents bool       `json:"resource_access_token_events"`
+	CustomWebhookTemplate     string     `json:"custom_webhook_template"`
+	EnableSSLVerification     bool       `json:"enable_ssl_verification"`
+	CreatedAt                 *time.Time `json:"created_at"`
 }
 
 // ListProjectHooksOptions represents the available ListProjectHooks() options.
@@ -942,20 +974,24 @@ func (s *ProjectsService) GetProjectHook(pid interface{}, hook int, options ...R
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#add-project-hook
 type AddProjectHookOptions struct {
-	URL                      *string `url:"url,omitempty" json:"url,omitempty"`
-	ConfidentialNoteEvents   *bool   `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
-	PushEvents               *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
-	PushEventsBranchFilter   *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
-	IssuesEvents             *bool   `url:"issues_events,omitempty" json:"issues_events,omitempty"`
-	ConfidentialIssuesEvents *bool   `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
-	MergeRequestsEvents      *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
-	TagPushEvents            *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
-	NoteEvents               *bool   `url:"note_events,omitempty" json:"note_events,omitempty"`
-	JobEvents                *bool   `url:"job_events,omitempty" json:"job_events,omitempty"`
-	PipelineEvents           *bool   `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
-	WikiPageEvents           *bool   `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
-	EnableSSLVerification    *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
-	Token                    *string `url:"token,omitempty" json:"token,omitempty"`
+	URL                       *string `url:"url,omitempty" json:"url,omitempty"`
+	ConfidentialNoteEvents    *bool   `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
+	PushEvents                *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
+	PushEventsBranchFilter    *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
+	IssuesEvents              *bool   `url:"issues_events,omitempty" json:"issues_events,omitempty"`
+	ConfidentialIssuesEvents  *bool   `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
+	MergeRequestsEvents       *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	TagPushEvents             *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
+	NoteEvents                *bool   `url:"note_events,omitempty" json:"note_events,omitempty"`
+	JobEvents                 *bool   `url:"job_events,omitempty" json:"job_events,omitempty"`
+	PipelineEvents            *bool   `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
+	WikiPageEvents            *bool   `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
+	DeploymentEvents          *bool   `url:"deployment_events,omitempty" json:"deployment_events,omitempty"`
+	ReleasesEvents            *bool   `url:"releases_events,omitempty" json:"releases_events,omitempty"`
+	ResourceAccessTokenEvents *bool   `url:"resource_access_token_events,omitempty" json:"resource_access_token_events,omitempty"`
+	CustomWebhookTemplate     *string `url:"custom_webhook_template,omitempty" json:"custom_webhook_template,omitempty"`
+	EnableSSLVerification     *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	Token                     *string `url:"token,omitempty" json:"token,omitempty"`
 }
 
 // AddProjectHook adds a hook to a specified project.
@@ -988,20 +1024,24 @@ func (s *ProjectsService) AddProjectHook(pid interface{}, opt *AddProjectHookOpt
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#edit-project-hook
 type EditProjectHookOptions struct {
-	URL                      *string `url:"url,omitempty" json:"url,omitempty"`
-	ConfidentialNoteEvents   *bool   `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
-	PushEvents               *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
-	PushEventsBranchFilter   *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
-	IssuesEvents             *bool   `url:"issues_events,omitempty" json:"issues_events,omitempty"`
-	ConfidentialIssuesEvents *bool   `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
-	MergeRequestsEvents      *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
-	TagPushEvents            *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
-	NoteEvents               *bool   `url:"note_events,omitempty" json:"note_events,omitempty"`
-	JobEvents                *bool   `url:"job_events,omitempty" json:"job_events,omitempty"`
-	PipelineEvents           *bool   `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
-	WikiPageEvents           *bool   `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
-	EnableSSLVerification    *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
-	Token                    *string `url:"token,omitempty" json:"token,omitempty"`
+	URL                       *string `url:"url,omitempty" json:"url,omitempty"`
+	ConfidentialNoteEvents    *bool   `url:"confidential_note_events,omitempty" json:"confidential_note_events,omitempty"`
+	PushEvents                *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
+	PushEventsBranchFilter    *string `url:"push_events_branch_filter,omitempty" json:"push_events_branch_filter,omitempty"`
+	IssuesEvents              *bool   `url:"issues_events,omitempty" json:"issues_events,omitempty"`
+	ConfidentialIssuesEvents  *bool   `url:"confidential_issues_events,omitempty" json:"confidential_issues_events,omitempty"`
+	MergeRequestsEvents       *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	TagPushEvents             *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
+	NoteEvents                *bool   `url:"note_events,omitempty" json:"note_events,omitempty"`
+	JobEvents                 *bool   `url:"job_events,omitempty" json:"job_events,omitempty"`
+	PipelineEvents            *bool   `url:"pipeline_events,omitempty" json:"pipeline_events,omitempty"`
+	WikiPageEvents            *bool   `url:"wiki_page_events,omitempty" json:"wiki_page_events,omitempty"`
+	DeploymentEvents          *bool   `url:"deployment_events,omitempty" json:"deployment_events,omitempty"`
+	ReleasesEvents            *bool   `url:"releases_events,omitempty" json:"releases_events,omitempty"`
+	ResourceAccessTokenEvents *bool   `url:"resource_access_token_events,omitempty" json:"resource_access_token_events,omitempty"`
+	CustomWebhookTemplate     *string `url:"custom_webhook_template,omitempty" json:"custom_webhook_template,omitempty"`
+	EnableSSLVerification     *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	Token                     *string `url:"token,omitempty" json:"token,omitempty"`
 }
 
 // EditProjectHook edits a hook for a specified project.
@@ -1049,6 +1089,26 @@ func (s *ProjectsService) DeleteProjectHook(pid interface{}, hook int, options .
 	return s.client.Do(req, nil)
 }
 
+// TriggerTestProjectHook triggers a test event for a project hook. If the
+// test event could not be delivered, the returned error contains the reason.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#trigger-a-test-project-hook
+func (s *ProjectsService) TriggerTestProjectHook(pid interface{}, hook int, event ProjectHookEventValue, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/hooks/%d/test/%s", pathEscape(project), hook, event)
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // ProjectForkRelation represents a project fork relationship.
 //
 // GitLab API docs:
@@ -1066,8 +1126,12 @@ type ProjectForkRelation struct {
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#create-a-forked-fromto-relation-between-existing-projects.
-func (s *ProjectsService) CreateProjectForkRelation(pid int, fork int, options ...RequestOptionFunc) (*ProjectForkRelation, *Response, error) {
-	u := fmt.Sprintf("projects/%d/fork/%d", pid, fork)
+func (s *ProjectsService) CreateProjectForkRelation(pid interface{}, fork int, options ...RequestOptionFunc) (*ProjectForkRelation, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/fork/%d", pathEscape(project), fork)
 
 	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
@@ -1087,8 +1151,12 @@ func (s *ProjectsService) CreateProjectForkRelation(pid int, fork int, options .
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/projects.html#delete-an-existing-forked-from-relationship
-func (s *ProjectsService) DeleteProjectForkRelation(pid int, options ...RequestOptionFunc) (*Response, error) {
-	u := fmt.Sprintf("projects/%d/fork", pid)
+func (s *ProjectsService) DeleteProjectForkRelation(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/fork", pathEscape(project))
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
 	if err != nil {
@@ -1201,7 +1269,13 @@ type ProjectPushRules struct {
 	RejectUnsignedCommits bool       `json:"reject_unsigned_commits"`
 }
 
-// GetProjectPushRules gets the push rules of a project.
+// ErrPushRuleNotFound is returned when requesting the push rules of a project
+// or group that doesn't have any push rules configured.
+var ErrPushRuleNotFound = errors.New("Push rule does not exist")
+
+// GetProjectPushRules gets the push rules of a project. If the project has no
+// push rules configured, ErrPushRuleNotFound is returned so the caller can
+// decide to add one using AddProjectPushRule.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/projects.html#get-project-push-rules
@@ -1217,10 +1291,21 @@ func (s *ProjectsService) GetProjectPushRules(pid interface{}, options ...Reques
 		return nil, nil, err
 	}
 
-	ppr := new(ProjectPushRules)
-	resp, err := s.client.Do(req, ppr)
+	var ppr *ProjectPushRules
+	resp, err := s.client.Do(req, &ppr)
 	if err != nil {
-		return nil, resp, err
+		// GitLab returns a 404 both when the project doesn't exist and when
+		// it has no push rules, so check if the project exists.
+		return nil, resp, probeNotFound(err, func() (*Response, error) {
+			_, resp, err := s.GetProject(pid, nil, options...)
+			return resp, err
+		}, ErrPushRuleNotFound, nil)
+	}
+
+	// Depending on the version, GitLab responds with null instead of a 404
+	// when no push rules are configured.
+	if ppr == nil {
+		return nil, resp, ErrPushRuleNotFound
 	}
 
 	return ppr, resp, err
@@ -1343,6 +1428,7 @@ type ProjectApprovals struct {
 	DisableOverridingApproversPerMergeRequest bool                         `json:"disable_overriding_approvers_per_merge_request"`
 	MergeRequestsAuthorApproval               bool                         `json:"merge_requests_author_approval"`
 	MergeRequestsDisableCommittersApproval    bool                         `json:"merge_requests_disable_committers_approval"`
+	RequirePasswordToApprove                  bool                         `json:"require_password_to_approve"`
 }
 
 // GetApprovalConfiguration get the approval configuration for a project.
@@ -1381,6 +1467,7 @@ type ChangeApprovalConfigurationOptions struct {
 	DisableOverridingApproversPerMergeRequest *bool `url:"disable_overriding_approvers_per_merge_request,omitempty" json:"disable_overriding_approvers_per_merge_request,omitempty"`
 	MergeRequestsAuthorApproval               *bool `url:"merge_requests_author_approval,omitempty" json:"merge_requests_author_approval,omitempty"`
 	MergeRequestsDisableCommittersApproval    *bool `url:"merge_requests_disable_committers_approval,omitempty" json:"merge_requests_disable_committers_approval,omitempty"`
+	RequirePasswordToApprove                  *bool `url:"require_password_to_approve,omitempty" json:"require_password_to_approve,omitempty"`
 }
 
 // ChangeApprovalConfiguration updates the approval configuration for a project.
@@ -1587,6 +1674,37 @@ func (s *ProjectsService) StartMirroringProject(pid interface{}, options ...Requ
 	return resp, err
 }
 
+// ErrHousekeepingInProgress is returned when triggering housekeeping for a
+// project while a housekeeping task is already running for it. The
+// ErrorResponse is wrapped, so check for it using errors.Is.
+var ErrHousekeepingInProgress = errors.New("Housekeeping is already running for this project")
+
+// TriggerHousekeeping starts the housekeeping task (git gc and repack) for a
+// project. If housekeeping is already running ErrHousekeepingInProgress is
+// returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#start-the-housekeeping-task-for-a-project
+func (s *ProjectsService) TriggerHousekeeping(pid interface{}, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf("projects/%s/housekeeping", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, wrapErrorResponse(err, http.StatusConflict, "", ErrHousekeepingInProgress)
+	}
+
+	return resp, err
+}
+
 // TransferProjectOptions represents the available TransferProject() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#transfer-a-project-to-a-new-namespace
@@ -1594,7 +1712,14 @@ type TransferProjectOptions struct {
 	Namespace interface{} `url:"namespace,omitempty" json:"namespace,omitempty"`
 }
 
-// TransferProject transfer a project into the specified namespace
+// ErrTransferPathTaken is returned when transferring a project or group
+// into a namespace that already has a project or group with the same path.
+// It wraps the ErrorResponse of GitLab, so check for it using errors.Is.
+var ErrTransferPathTaken = errors.New("Target namespace already has a project or group with the same path")
+
+// TransferProject transfer a project into the specified namespace. If the
+// namespace already has a project with the same path ErrTransferPathTaken is
+// returned.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#transfer-a-project-to-a-new-namespace
 func (s *ProjectsService) TransferProject(pid interface{}, opt *TransferProjectOptions, options ...RequestOptionFunc) (*Project, *Response, error) {
@@ -1612,8 +1737,16 @@ func (s *ProjectsService) TransferProject(pid interface{}, opt *TransferProjectO
 	p := new(Project)
 	resp, err := s.client.Do(req, p)
 	if err != nil {
-		return nil, resp, err
+		return nil, resp, transferError(err)
 	}
 
 	return p, resp, err
 }
+
+// transferError wraps the validation error GitLab returns when the target
+// namespace of a transfer already contains a project or group with the same
+// path with ErrTransferPathTaken.
+func transferError(err error) error {
+	err = wrapErrorResponse(err, http.StatusBadRequest, "already exists", ErrTransferPathTaken)
+	return wrapErrorResponse(err, http.StatusBadRequest, "same path", ErrTransferPathTaken)
+}
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -261,6 +262,53 @@ func TestGetProjectWithOptions(t *testing.T) {
 	}
 }
 
+func TestGetProjectStatistics(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1?statistics=true")
+		fmt.Fprint(w, `{
+			"id":1,
+			"statistics": {
+				"commit_count": 37,
+				"storage_size": 5368709120000,
+				"repository_size": 1038090,
+				"wiki_size": 2048,
+				"lfs_objects_size": 4294967296000,
+				"job_artifacts_size": 1024,
+				"pipeline_artifacts_size": 512,
+				"packages_size": 256,
+				"snippets_size": 128,
+				"uploads_size": 64
+			}}`)
+	})
+	want := &ProjectStatistics{
+		CommitCount: 37,
+		StorageStatistics: StorageStatistics{
+			StorageSize:           5368709120000,
+			RepositorySize:        1038090,
+			WikiSize:              2048,
+			LfsObjectsSize:        4294967296000,
+			JobArtifactsSize:      1024,
+			PipelineArtifactsSize: 512,
+			PackagesSize:          256,
+			SnippetsSize:          128,
+			UploadsSize:           64,
+		},
+	}
+
+	stats, _, err := client.Projects.GetProjectStatistics(1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectStatistics returns an error: %v", err)
+	}
+
+	if !reflect.DeepEqual(want, stats) {
+		t.Errorf("Projects.GetProjectStatistics returned %+v, want %+v", stats, want)
+	}
+}
+
 func TestCreateProject(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -401,7 +449,8 @@ func TestGetApprovalConfiguration(t *testing.T) {
 			"reset_approvals_on_push": false,
 			"disable_overriding_approvers_per_merge_request": false,
 			"merge_requests_author_approval": true,
-			"merge_requests_disable_committers_approval": true
+			"merge_requests_disable_committers_approval": true,
+			"require_password_to_approve": true
 		}`)
 	})
 
@@ -418,6 +467,7 @@ func TestGetApprovalConfiguration(t *testing.T) {
 		DisableOverridingApproversPerMergeRequest: false,
 		MergeRequestsAuthorApproval:               true,
 		MergeRequestsDisableCommittersApproval:    true,
+		RequirePasswordToApprove:                  true,
 	}
 
 	if !reflect.DeepEqual(want, approvals) {
@@ -431,7 +481,7 @@ func TestChangeApprovalConfiguration(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/approvals", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "POST")
-		testBody(t, r, `{"approvals_before_merge":3}`)
+		testBody(t, r, `{"approvals_before_merge":3,"reset_approvals_on_push":false,"require_password_to_approve":true}`)
 		fmt.Fprint(w, `{
 			"approvers": [],
 			"approver_groups": [],
@@ -439,12 +489,15 @@ func TestChangeApprovalConfiguration(t *testing.T) {
 			"reset_approvals_on_push": false,
 			"disable_overriding_approvers_per_merge_request": false,
 			"merge_requests_author_approval": true,
-			"merge_requests_disable_committers_approval": true
+			"merge_requests_disable_committers_approval": true,
+			"require_password_to_approve": true
 		}`)
 	})
 
 	opt := &ChangeApprovalConfigurationOptions{
-		ApprovalsBeforeMerge: Int(3),
+		ApprovalsBeforeMerge:     Int(3),
+		ResetApprovalsOnPush:     Bool(false),
+		RequirePasswordToApprove: Bool(true),
 	}
 
 	approvals, _, err := client.Projects.ChangeApprovalConfiguration(1, opt)
@@ -460,6 +513,7 @@ func TestChangeApprovalConfiguration(t *testing.T) {
 		DisableOverridingApproversPerMergeRequest: false,
 		MergeRequestsAuthorApproval:               true,
 		MergeRequestsDisableCommittersApproval:    true,
+		RequirePasswordToApprove:                  true,
 	}
 
 	if !reflect.DeepEqual(want, approvals) {
@@ -553,6 +607,87 @@ func TestForkProject(t *testing.T) {
 	}
 }
 
+func TestForkProjectIntoNamespace(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/fork", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"namespace_path":"team/forks","visibility":"private"}`)
+		fmt.Fprint(w, `{"id":2,"forked_from_project":{"id":1}}`)
+	})
+
+	project, _, err := client.Projects.ForkProject(1, &ForkProjectOptions{
+		NamespacePath: String("team/forks"),
+		Visibility:    Visibility(PrivateVisibility),
+	})
+	if err != nil {
+		t.Fatalf("Projects.ForkProject returned error: %v", err)
+	}
+
+	want := &Project{ID: 2, ForkedFromProject: &ForkParent{ID: 1}}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.ForkProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestCreateProjectForkRelation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/2/fork/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":10,"forked_to_project_id":2,"forked_from_project_id":1}`)
+	})
+
+	rel, _, err := client.Projects.CreateProjectForkRelation(2, 1)
+	if err != nil {
+		t.Fatalf("Projects.CreateProjectForkRelation returned error: %v", err)
+	}
+
+	want := &ProjectForkRelation{ID: 10, ForkedToProjectID: 2, ForkedFromProjectID: 1}
+	if !reflect.DeepEqual(want, rel) {
+		t.Errorf("Projects.CreateProjectForkRelation returned %+v, want %+v", rel, want)
+	}
+}
+
+func TestDeleteProjectForkRelation(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testURL(t, r, "/api/v4/projects/namespace%2Fname/fork")
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Projects.DeleteProjectForkRelation("namespace/name")
+	if err != nil {
+		t.Errorf("Projects.DeleteProjectForkRelation returned error: %v", err)
+	}
+}
+
+func TestGetProjectLanguages(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/languages", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"Go":86.41,"Shell":13.59}`)
+	})
+
+	languages, _, err := client.Projects.GetProjectLanguages(1)
+	if err != nil {
+		t.Fatalf("Projects.GetProjectLanguages returned error: %v", err)
+	}
+
+	want := &ProjectLanguages{"Go": 86.41, "Shell": 13.59}
+	if !reflect.DeepEqual(want, languages) {
+		t.Errorf("Projects.GetProjectLanguages returned %+v, want %+v", languages, want)
+	}
+}
+
 func TestGetProjectApprovalRules(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -905,3 +1040,230 @@ func TestCreateProjectApprovalRule(t *testing.T) {
 		t.Errorf("Projects.CreateProjectApprovalRule returned %+v, want %+v", rule, want)
 	}
 }
+
+func TestGetProjectPushRules(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1, "project_id": 1, "commit_message_regex": "Fixes \\d+\\..*", "max_file_size": 5, "prevent_secrets": true}`)
+	})
+
+	rule, _, err := client.Projects.GetProjectPushRules(1)
+	if err != nil {
+		t.Errorf("Projects.GetProjectPushRules returned error: %v", err)
+	}
+
+	want := &ProjectPushRules{
+		ID:                 1,
+		ProjectID:          1,
+		CommitMessageRegex: `Fixes \d+\..*`,
+		MaxFileSize:        5,
+		PreventSecrets:     true,
+	}
+
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("Projects.GetProjectPushRules returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestGetProjectPushRulesNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Push Rule Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/2/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `null`)
+	})
+	mux.HandleFunc("/api/v4/projects/3/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "404 Project Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 1}`)
+	})
+
+	for _, pid := range []int{1, 2} {
+		_, _, err := client.Projects.GetProjectPushRules(pid)
+		if !errors.Is(err, ErrPushRuleNotFound) {
+			t.Errorf("Projects.GetProjectPushRules(%d) returned error %v, want %v", pid, err, ErrPushRuleNotFound)
+		}
+	}
+
+	_, _, err := client.Projects.GetProjectPushRules(3)
+	if _, ok := err.(*ErrorResponse); !ok {
+		t.Errorf("Projects.GetProjectPushRules returned error %v, want *ErrorResponse", err)
+	}
+}
+
+func TestEditProjectPushRule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/push_rule", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"branch_name_regex":"^(feature|fix)/","member_check":true}`)
+		fmt.Fprint(w, `{"id": 1, "project_id": 1, "branch_name_regex": "^(feature|fix)/", "member_check": true}`)
+	})
+
+	opt := &EditProjectPushRuleOptions{
+		BranchNameRegex: String("^(feature|fix)/"),
+		MemberCheck:     Bool(true),
+	}
+	rule, _, err := client.Projects.EditProjectPushRule(1, opt)
+	if err != nil {
+		t.Errorf("Projects.EditProjectPushRule returned error: %v", err)
+	}
+
+	want := &ProjectPushRules{ID: 1, ProjectID: 1, BranchNameRegex: "^(feature|fix)/", MemberCheck: true}
+	if !reflect.DeepEqual(want, rule) {
+		t.Errorf("Projects.EditProjectPushRule returned %+v, want %+v", rule, want)
+	}
+}
+
+func TestAddProjectHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"url":"https://example.com/hook","deployment_events":true,"releases_events":true,"resource_access_token_events":true,"custom_webhook_template":"{\"event\":\"{{object_kind}}\"}"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"url": "https://example.com/hook",
+			"project_id": 1,
+			"deployment_events": true,
+			"releases_events": true,
+			"resource_access_token_events": true,
+			"custom_webhook_template": "{\"event\":\"{{object_kind}}\"}"
+		}`)
+	})
+
+	opt := &AddProjectHookOptions{
+		URL:                       String("https://example.com/hook"),
+		DeploymentEvents:          Bool(true),
+		ReleasesEvents:            Bool(true),
+		ResourceAccessTokenEvents: Bool(true),
+		CustomWebhookTemplate:     String(`{"event":"{{object_kind}}"}`),
+	}
+	hook, _, err := client.Projects.AddProjectHook(1, opt)
+	if err != nil {
+		t.Fatalf("Projects.AddProjectHook returned error: %v", err)
+	}
+
+	want := &ProjectHook{
+		ID:                        1,
+		URL:                       "https://example.com/hook",
+		ProjectID:                 1,
+		DeploymentEvents:          true,
+		ReleasesEvents:            true,
+		ResourceAccessTokenEvents: true,
+		CustomWebhookTemplate:     `{"event":"{{object_kind}}"}`,
+	}
+	if !reflect.DeepEqual(want, hook) {
+		t.Errorf("Projects.AddProjectHook returned %+v, want %+v", hook, want)
+	}
+}
+
+func TestTriggerTestProjectHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/test/push_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"message":"201 Created"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/hooks/2/test/releases_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		fmt.Fprint(w, `{"message":"Hook execution failed: Failed to open TCP connection"}`)
+	})
+
+	_, err := client.Projects.TriggerTestProjectHook(1, 2, PushProjectHookEvent)
+	if err != nil {
+		t.Fatalf("Projects.TriggerTestProjectHook returned error: %v", err)
+	}
+
+	resp, err := client.Projects.TriggerTestProjectHook(1, 2, ReleasesProjectHookEvent)
+	if err == nil {
+		t.Fatal("Projects.TriggerTestProjectHook expected an error for a failed delivery")
+	}
+	if resp.StatusCode != http.StatusUnprocessableEntity {
+		t.Errorf("Projects.TriggerTestProjectHook returned status %d, want %d", resp.StatusCode, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestTransferProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/transfer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"namespace":"new-team"}`)
+		fmt.Fprint(w, `{"id":1,"path_with_namespace":"new-team/project"}`)
+	})
+
+	project, _, err := client.Projects.TransferProject(1, &TransferProjectOptions{Namespace: "new-team"})
+	if err != nil {
+		t.Fatalf("Projects.TransferProject returned error: %v", err)
+	}
+
+	want := &Project{ID: 1, PathWithNamespace: "new-team/project"}
+	if !reflect.DeepEqual(want, project) {
+		t.Errorf("Projects.TransferProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestTransferProjectPathTaken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/transfer", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Transfer failed: Project with same name or path in target namespace already exists"}`)
+	})
+
+	_, _, err := client.Projects.TransferProject(1, &TransferProjectOptions{Namespace: 2})
+	if !errors.Is(err, ErrTransferPathTaken) {
+		t.Errorf("Projects.TransferProject returned error %v, want %v", err, ErrTransferPathTaken)
+	}
+}
+
+func TestTriggerHousekeeping(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/housekeeping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Projects.TriggerHousekeeping(1)
+	if err != nil {
+		t.Fatalf("Projects.TriggerHousekeeping returned error: %v", err)
+	}
+}
+
+func TestTriggerHousekeepingInProgress(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/housekeeping", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"message":"Failed to obtain a lock"}`)
+	})
+
+	_, err := client.Projects.TriggerHousekeeping(1)
+	if !errors.Is(err, ErrHousekeepingInProgress) {
+		t.Errorf("Projects.TriggerHousekeeping returned error %v, want %v", err, ErrHousekeepingInProgress)
+	}
+}
@@ -36,6 +36,7 @@ type ProtectedBranchesService struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/protected_branches.html#protected-branches-api
 type BranchAccessDescription struct {
+	ID                     int              `json:"id"`
 	AccessLevel            AccessLevelValue `json:"access_level"`
 	UserID                 int              `json:"user_id"`
 	GroupID                int              `json:"group_id"`
@@ -52,6 +53,7 @@ type ProtectedBranch struct {
 	PushAccessLevels          []*BranchAccessDescription `json:"push_access_levels"`
 	MergeAccessLevels         []*BranchAccessDescription `json:"merge_access_levels"`
 	UnprotectAccessLevels     []*BranchAccessDescription `json:"unprotect_access_levels"`
+	AllowForcePush            bool                       `json:"allow_force_push"`
 	CodeOwnerApprovalRequired bool                       `json:"code_owner_approval_required"`
 }
 
@@ -125,6 +127,7 @@ type ProtectRepositoryBranchesOptions struct {
 	AllowedToPush             []*ProtectBranchPermissionOptions `url:"allowed_to_push,omitempty" json:"allowed_to_push,omitempty"`
 	AllowedToMerge            []*ProtectBranchPermissionOptions `url:"allowed_to_merge,omitempty" json:"allowed_to_merge,omitempty"`
 	AllowedToUnprotect        []*ProtectBranchPermissionOptions `url:"allowed_to_unprotect,omitempty" json:"allowed_to_unprotect,omitempty"`
+	AllowForcePush            *bool                             `url:"allow_force_push,omitempty" json:"allow_force_push,omitempty"`
 	CodeOwnerApprovalRequired *bool                             `url:"code_owner_approval_required,omitempty" json:"code_owner_approval_required,omitempty"`
 }
 
@@ -211,3 +214,57 @@ func (s *ProtectedBranchesService) RequireCodeOwnerApprovals(pid interface{}, br
 
 	return s.client.Do(req, nil)
 }
+
+// UpdateProtectedBranchOptions represents the available
+// UpdateProtectedBranch() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html#update-a-protected-branch
+type UpdateProtectedBranchOptions struct {
+	Name                      *string                    `url:"name,omitempty" json:"name,omitempty"`
+	AllowForcePush            *bool                      `url:"allow_force_push,omitempty" json:"allow_force_push,omitempty"`
+	CodeOwnerApprovalRequired *bool                      `url:"code_owner_approval_required,omitempty" json:"code_owner_approval_required,omitempty"`
+	AllowedToPush             []*BranchPermissionOptions `url:"allowed_to_push,omitempty" json:"allowed_to_push,omitempty"`
+	AllowedToMerge            []*BranchPermissionOptions `url:"allowed_to_merge,omitempty" json:"allowed_to_merge,omitempty"`
+	AllowedToUnprotect        []*BranchPermissionOptions `url:"allowed_to_unprotect,omitempty" json:"allowed_to_unprotect,omitempty"`
+}
+
+// BranchPermissionOptions represents a branch permission when updating a
+// protected branch. Leave ID unset to add a new permission, set ID to change
+// an existing one, or set ID and Destroy to remove it.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html#update-a-protected-branch
+type BranchPermissionOptions struct {
+	ID          *int              `url:"id,omitempty" json:"id,omitempty"`
+	UserID      *int              `url:"user_id,omitempty" json:"user_id,omitempty"`
+	GroupID     *int              `url:"group_id,omitempty" json:"group_id,omitempty"`
+	AccessLevel *AccessLevelValue `url:"access_level,omitempty" json:"access_level,omitempty"`
+	Destroy     *bool             `url:"_destroy,omitempty" json:"_destroy,omitempty"`
+}
+
+// UpdateProtectedBranch updates a protected branch in place, so the branch
+// stays protected while its settings change.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/protected_branches.html#update-a-protected-branch
+func (s *ProtectedBranchesService) UpdateProtectedBranch(pid interface{}, branch string, opt *UpdateProtectedBranchOptions, options ...RequestOptionFunc) (*ProtectedBranch, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/protected_branches/%s", pathEscape(project), url.PathEscape(branch))
+
+	req, err := s.client.NewRequest("PATCH", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p := new(ProtectedBranch)
+	resp, err := s.client.Do(req, p)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return p, resp, err
+}
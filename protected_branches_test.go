@@ -167,10 +167,7 @@ func TestUpdateRepositoryBranches(t *testing.T) {
 
 	mux.HandleFunc("/api/v4/projects/1/protected_branches/master", func(w http.ResponseWriter, r *http.Request) {
 		testMethod(t, r, "PATCH")
-		codeApprovalQueryParam := r.URL.Query().Get("code_owner_approval_required")
-		if codeApprovalQueryParam != "true" {
-			t.Errorf("query param code_owner_approval_required should be true but was %s", codeApprovalQueryParam)
-		}
+		testBody(t, r, `{"code_owner_approval_required":true}`)
 	})
 	opt := &RequireCodeOwnerApprovalsOptions{
 		CodeOwnerApprovalRequired: Bool(true),
@@ -180,3 +177,47 @@ func TestUpdateRepositoryBranches(t *testing.T) {
 		t.Errorf("ProtectedBranches.UpdateRepositoryBranchesOptions returned error: %v", err)
 	}
 }
+
+func TestUpdateProtectedBranch(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_branches/release/v1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PATCH")
+		testURL(t, r, "/api/v4/projects/1/protected_branches/release%2Fv1")
+		testBody(t, r, `{"allow_force_push":true,"code_owner_approval_required":true,"allowed_to_push":[{"access_level":40},{"id":3,"_destroy":true}]}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"name": "release/v1",
+			"push_access_levels": [{"id": 4, "access_level": 40, "access_level_description": "Maintainers"}],
+			"allow_force_push": true,
+			"code_owner_approval_required": true
+		}`)
+	})
+
+	opt := &UpdateProtectedBranchOptions{
+		AllowForcePush:            Bool(true),
+		CodeOwnerApprovalRequired: Bool(true),
+		AllowedToPush: []*BranchPermissionOptions{
+			{AccessLevel: AccessLevel(MaintainerPermissions)},
+			{ID: Int(3), Destroy: Bool(true)},
+		},
+	}
+	branch, _, err := client.ProtectedBranches.UpdateProtectedBranch(1, "release/v1", opt)
+	if err != nil {
+		t.Fatalf("ProtectedBranches.UpdateProtectedBranch returned error: %v", err)
+	}
+
+	want := &ProtectedBranch{
+		ID:   1,
+		Name: "release/v1",
+		PushAccessLevels: []*BranchAccessDescription{
+			{ID: 4, AccessLevel: 40, AccessLevelDescription: "Maintainers"},
+		},
+		AllowForcePush:            true,
+		CodeOwnerApprovalRequired: true,
+	}
+	if !reflect.DeepEqual(want, branch) {
+		t.Errorf("ProtectedBranches.UpdateProtectedBranch returned %+v, want %+v", branch, want)
+	}
+}
@@ -0,0 +1,119 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListProtectedEnvironments(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"name":"production", "deploy_access_levels": [{"id": 1, "access_level": 40, "access_level_description": "Maintainers", "user_id": null, "group_id": null}], "required_approval_count": 1}]`)
+	})
+
+	expected := []*ProtectedEnvironment{
+		{
+			Name: "production",
+			DeployAccessLevels: []*EnvironmentAccessDescription{
+				{
+					ID:                     1,
+					AccessLevel:            40,
+					AccessLevelDescription: "Maintainers",
+				},
+			},
+			RequiredApprovalCount: 1,
+		},
+	}
+
+	opt := &ListProtectedEnvironmentsOptions{}
+	environments, _, err := client.ProtectedEnvironments.ListProtectedEnvironments(1, opt)
+	assert.NoError(t, err, "failed to get response")
+	assert.Equal(t, expected, environments)
+}
+
+func TestGetProtectedEnvironment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"name":"production", "deploy_access_levels": [{"id": 1, "access_level": 40, "access_level_description": "Maintainers"}], "required_approval_count": 2, "approval_rules": [{"id": 1, "user_id": null, "group_id": 10, "access_level": null, "access_level_description": "qa-group", "required_approvals": 2}]}`)
+	})
+
+	expected := &ProtectedEnvironment{
+		Name: "production",
+		DeployAccessLevels: []*EnvironmentAccessDescription{
+			{
+				ID:                     1,
+				AccessLevel:            40,
+				AccessLevelDescription: "Maintainers",
+			},
+		},
+		RequiredApprovalCount: 2,
+		ApprovalRules: []*EnvironmentApprovalRule{
+			{
+				ID:                     1,
+				GroupID:                10,
+				AccessLevelDescription: "qa-group",
+				RequiredApprovalCount:  2,
+			},
+		},
+	}
+
+	environment, _, err := client.ProtectedEnvironments.GetProtectedEnvironment(1, "production")
+	assert.NoError(t, err, "failed to get response")
+	assert.Equal(t, expected, environment)
+}
+
+func TestProtectProtectedEnvironment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_environments", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"production","deploy_access_levels":[{"access_level":40},{"user_id":5},{"group_id":9}],"required_approval_count":1}`)
+		fmt.Fprint(w, `{"name":"production", "deploy_access_levels": [{"id": 1, "access_level": 40, "access_level_description": "Maintainers"}, {"id": 2, "access_level": 40, "access_level_description": "Jane", "user_id": 5}, {"id": 3, "access_level": 40, "access_level_description": "Ops", "group_id": 9}], "required_approval_count": 1}`)
+	})
+
+	expected := &ProtectedEnvironment{
+		Name: "production",
+		DeployAccessLevels: []*EnvironmentAccessDescription{
+			{ID: 1, AccessLevel: 40, AccessLevelDescription: "Maintainers"},
+			{ID: 2, AccessLevel: 40, AccessLevelDescription: "Jane", UserID: 5},
+			{ID: 3, AccessLevel: 40, AccessLevelDescription: "Ops", GroupID: 9},
+		},
+		RequiredApprovalCount: 1,
+	}
+
+	opt := &ProtectProtectedEnvironmentOptions{
+		Name: String("production"),
+		DeployAccessLevels: []*EnvironmentAccessOptions{
+			{AccessLevel: AccessLevel(MaintainerPermissions)},
+			{UserID: Int(5)},
+			{GroupID: Int(9)},
+		},
+		RequiredApprovalCount: Int(1),
+	}
+	environment, _, err := client.ProtectedEnvironments.ProtectProtectedEnvironment(1, opt)
+	assert.NoError(t, err, "failed to get response")
+	assert.Equal(t, expected, environment)
+}
+
+func TestUnprotectProtectedEnvironment(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/protected_environments/production", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	resp, err := client.ProtectedEnvironments.UnprotectProtectedEnvironment(1, "production")
+	assert.NoError(t, err, "failed to get response")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,123 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// PyPIPackagesService handles communication with the PyPI package registry
+// methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/pypi.html
+type PyPIPackagesService struct {
+	client *Client
+}
+
+// PyPIPackageFile represents a single file listed in the PyPI simple index of
+// a package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/pypi.html#group-level-simple-api-entry-point
+type PyPIPackageFile struct {
+	FileName       string
+	URL            string
+	SHA256         string
+	RequiresPython string
+}
+
+// pypiSimpleIndexLink matches the anchors of a PEP 503 simple index page.
+var pypiSimpleIndexLink = regexp.MustCompile(`<a\s+href="([^"]*)"([^>]*)>([^<]*)</a>`)
+
+// pypiRequiresPython matches the data-requires-python attribute of an anchor.
+var pypiRequiresPython = regexp.MustCompile(`data-requires-python="([^"]*)"`)
+
+// GetSimpleIndex lists the files of a PyPI package by parsing the simple
+// index page of the package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/pypi.html#project-level-simple-api-entry-point
+func (s *PyPIPackagesService) GetSimpleIndex(pid interface{}, packageName string, options ...RequestOptionFunc) ([]*PyPIPackageFile, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/packages/pypi/simple/%s", pathEscape(project), pathEscape(packageName))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var b bytes.Buffer
+	resp, err := s.client.Do(req, &b)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return parsePyPISimpleIndex(b.String()), resp, err
+}
+
+func parsePyPISimpleIndex(page string) []*PyPIPackageFile {
+	var files []*PyPIPackageFile
+	for _, m := range pypiSimpleIndexLink.FindAllStringSubmatch(page, -1) {
+		f := &PyPIPackageFile{
+			FileName: html.UnescapeString(strings.TrimSpace(m[3])),
+			URL:      html.UnescapeString(m[1]),
+		}
+		if u, err := url.Parse(f.URL); err == nil && strings.HasPrefix(u.Fragment, "sha256=") {
+			f.SHA256 = strings.TrimPrefix(u.Fragment, "sha256=")
+		}
+		if rp := pypiRequiresPython.FindStringSubmatch(m[2]); rp != nil {
+			f.RequiresPython = html.UnescapeString(rp[1])
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+// DownloadPackageFile downloads a PyPI package file. The complete file is
+// kept in memory, use DownloadPackageFileToWriter for large files.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/pypi.html#download-a-package-file-from-a-project
+func (s *PyPIPackagesService) DownloadPackageFile(pid interface{}, sha256, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	var f bytes.Buffer
+	resp, err := s.DownloadPackageFileToWriter(pid, sha256, fileName, &f, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return f.Bytes(), resp, err
+}
+
+// DownloadPackageFileToWriter downloads a PyPI package file and streams its
+// content to w as it arrives. The sha256 and file name are the ones listed in
+// the simple index of the package.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/packages/pypi.html#download-a-package-file-from-a-project
+func (s *PyPIPackagesService) DownloadPackageFileToWriter(pid interface{}, sha256, fileName string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/packages/pypi/files/%s/%s",
+		pathEscape(project),
+		pathEscape(sha256),
+		pathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
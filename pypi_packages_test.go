@@ -0,0 +1,60 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPyPIGetSimpleIndex(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/pypi/simple/my.package", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/packages/pypi/simple/my%2Epackage")
+		fmt.Fprint(w, `<!DOCTYPE html>
+<html>
+  <body>
+    <h1>Links for my.package</h1>
+    <a href="https://gitlab.example.com/api/v4/projects/1/packages/pypi/files/5y57017232013c8ac80647f4ca153119/my.package-0.1.tar.gz#sha256=5y57017232013c8ac80647f4ca153119" data-requires-python="&gt;=3.6">my.package-0.1.tar.gz</a><br>
+    <a href="https://gitlab.example.com/api/v4/projects/1/packages/pypi/files/9s9w01b0bcd52b709ec052084e33a5517ffca96f7728ddd9f8866a30cdf76f2/my.package-0.1-py3-none-any.whl#sha256=9s9w01b0bcd52b709ec052084e33a5517ffca96f7728ddd9f8866a30cdf76f2" data-requires-python="">my.package-0.1-py3-none-any.whl</a><br>
+  </body>
+</html>`)
+	})
+
+	files, _, err := client.PyPIPackages.GetSimpleIndex(1, "my.package")
+	require.NoError(t, err)
+
+	want := []*PyPIPackageFile{
+		{
+			FileName:       "my.package-0.1.tar.gz",
+			URL:            "https://gitlab.example.com/api/v4/projects/1/packages/pypi/files/5y57017232013c8ac80647f4ca153119/my.package-0.1.tar.gz#sha256=5y57017232013c8ac80647f4ca153119",
+			SHA256:         "5y57017232013c8ac80647f4ca153119",
+			RequiresPython: ">=3.6",
+		},
+		{
+			FileName: "my.package-0.1-py3-none-any.whl",
+			URL:      "https://gitlab.example.com/api/v4/projects/1/packages/pypi/files/9s9w01b0bcd52b709ec052084e33a5517ffca96f7728ddd9f8866a30cdf76f2/my.package-0.1-py3-none-any.whl#sha256=9s9w01b0bcd52b709ec052084e33a5517ffca96f7728ddd9f8866a30cdf76f2",
+			SHA256:   "9s9w01b0bcd52b709ec052084e33a5517ffca96f7728ddd9f8866a30cdf76f2",
+		},
+	}
+	assert.Equal(t, want, files)
+}
+
+func TestPyPIDownloadPackageFile(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/packages/pypi/files/abc123/my.package-0.1.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, "tarball")
+	})
+
+	file, _, err := client.PyPIPackages.DownloadPackageFile(1, "abc123", "my.package-0.1.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("tarball"), file)
+}
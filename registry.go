@@ -17,11 +17,15 @@ type ContainerRegistryService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ee/api/container_registry.html
 type RegistryRepository struct {
-	ID        int        `json:"id"`
-	Name      string     `json:"name"`
-	Path      string     `json:"path"`
-	Location  string     `json:"location"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID                     int                      `json:"id"`
+	Name                   string                   `json:"name"`
+	Path                   string                   `json:"path"`
+	ProjectID              int                      `json:"project_id"`
+	Location               string                   `json:"location"`
+	CreatedAt              *time.Time               `json:"created_at"`
+	CleanupPolicyStartedAt *time.Time               `json:"cleanup_policy_started_at"`
+	TagsCount              int                      `json:"tags_count"`
+	Tags                   []*RegistryRepositoryTag `json:"tags"`
 }
 
 func (s RegistryRepository) String() string {
@@ -47,11 +51,15 @@ func (s RegistryRepositoryTag) String() string {
 }
 
 // ListRegistryRepositoriesOptions represents the available
-// ListRegistryRepositories() options.
+// ListRegistryRepositories() and ListGroupRegistryRepositories() options.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#list-registry-repositories
-type ListRegistryRepositoriesOptions ListOptions
+type ListRegistryRepositoriesOptions struct {
+	ListOptions
+	Tags      *bool `url:"tags,omitempty" json:"tags,omitempty"`
+	TagsCount *bool `url:"tags_count,omitempty" json:"tags_count,omitempty"`
+}
 
 // ListRegistryRepositories gets a list of registry repositories in a project.
 //
@@ -78,6 +86,32 @@ func (s *ContainerRegistryService) ListRegistryRepositories(pid interface{}, opt
 	return repos, resp, err
 }
 
+// ListGroupRegistryRepositories gets a list of registry repositories in a
+// group.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/container_registry.html#within-a-group
+func (s *ContainerRegistryService) ListGroupRegistryRepositories(gid interface{}, opt *ListRegistryRepositoriesOptions, options ...RequestOptionFunc) ([]*RegistryRepository, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/registry/repositories", pathEscape(group))
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var repos []*RegistryRepository
+	resp, err := s.client.Do(req, &repos)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return repos, resp, err
+}
+
 // DeleteRegistryRepository deletes a repository in a registry.
 //
 // GitLab API docs:
@@ -144,7 +178,7 @@ func (s *ContainerRegistryService) GetRegistryRepositoryTagDetail(pid interface{
 	u := fmt.Sprintf("projects/%s/registry/repositories/%d/tags/%s",
 		pathEscape(project),
 		repository,
-		tagName,
+		pathEscape(tagName),
 	)
 
 	req, err := s.client.NewRequest("GET", u, nil, options)
@@ -173,7 +207,7 @@ func (s *ContainerRegistryService) DeleteRegistryRepositoryTag(pid interface{},
 	u := fmt.Sprintf("projects/%s/registry/repositories/%d/tags/%s",
 		pathEscape(project),
 		repository,
-		tagName,
+		pathEscape(tagName),
 	)
 
 	req, err := s.client.NewRequest("DELETE", u, nil, options)
@@ -190,13 +224,19 @@ func (s *ContainerRegistryService) DeleteRegistryRepositoryTag(pid interface{},
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#delete-repository-tags-in-bulk
 type DeleteRegistryRepositoryTagsOptions struct {
+	NameRegexpDelete *string `url:"name_regex_delete,omitempty" json:"name_regex_delete,omitempty"`
+	NameRegexpKeep   *string `url:"name_regex_keep,omitempty" json:"name_regex_keep,omitempty"`
+	KeepN            *int    `url:"keep_n,omitempty" json:"keep_n,omitempty"`
+	OlderThan        *string `url:"older_than,omitempty" json:"older_than,omitempty"`
+
+	// Deprecated: NameRegexp is an alias for NameRegexpDelete, use that
+	// instead.
 	NameRegexp *string `url:"name_regex,omitempty" json:"name_regex,omitempty"`
-	KeepN      *int    `url:"keep_n,omitempty" json:"keep_n,omitempty"`
-	OlderThan  *string `url:"older_than,omitempty" json:"older_than,omitempty"`
 }
 
 // DeleteRegistryRepositoryTags deletes repository tags in bulk based on
-// given criteria.
+// given criteria. The tags are deleted asynchronously, so GitLab responds
+// with 202 Accepted once the deletion is scheduled.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ee/api/container_registry.html#delete-repository-tags-in-bulk
@@ -0,0 +1,127 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListRegistryRepositories(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/registry/repositories?tags=true&tags_count=true")
+		fmt.Fprint(w, `[{
+			"id": 1,
+			"name": "",
+			"path": "group/project",
+			"project_id": 9,
+			"location": "gitlab.example.com:5000/group/project",
+			"created_at": "2019-01-10T13:38:57.391Z",
+			"cleanup_policy_started_at": "2020-01-10T15:40:57.391Z",
+			"tags_count": 1,
+			"tags": [{"name": "0.0.1", "path": "group/project:0.0.1", "location": "gitlab.example.com:5000/group/project:0.0.1"}]
+		}]`)
+	})
+
+	opt := &ListRegistryRepositoriesOptions{Tags: Bool(true), TagsCount: Bool(true)}
+	repos, _, err := client.ContainerRegistry.ListRegistryRepositories(5, opt)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2019, time.January, 10, 13, 38, 57, 391000000, time.UTC)
+	cleanupAt := time.Date(2020, time.January, 10, 15, 40, 57, 391000000, time.UTC)
+	want := []*RegistryRepository{{
+		ID:                     1,
+		Path:                   "group/project",
+		ProjectID:              9,
+		Location:               "gitlab.example.com:5000/group/project",
+		CreatedAt:              &createdAt,
+		CleanupPolicyStartedAt: &cleanupAt,
+		TagsCount:              1,
+		Tags: []*RegistryRepositoryTag{{
+			Name:     "0.0.1",
+			Path:     "group/project:0.0.1",
+			Location: "gitlab.example.com:5000/group/project:0.0.1",
+		}},
+	}}
+	assert.Equal(t, want, repos)
+}
+
+func TestListGroupRegistryRepositories(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/2/registry/repositories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id": 2, "name": "releases", "path": "group/project/releases", "project_id": 11}]`)
+	})
+
+	repos, _, err := client.ContainerRegistry.ListGroupRegistryRepositories(2, nil)
+	require.NoError(t, err)
+
+	want := []*RegistryRepository{{ID: 2, Name: "releases", Path: "group/project/releases", ProjectID: 11}}
+	assert.Equal(t, want, repos)
+}
+
+func TestGetRegistryRepositoryTagDetail(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags/v10.0.0", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/registry/repositories/2/tags/v10%2E0%2E0")
+		fmt.Fprint(w, `{
+			"name": "v10.0.0",
+			"path": "group/project:v10.0.0",
+			"location": "gitlab.example.com:5000/group/project:v10.0.0",
+			"revision": "e9ed9d87c881d8c2fd3a31b41904d01ba0b836e7fd15240d774d811a1c248181",
+			"short_revision": "e9ed9d87c",
+			"digest": "sha256:c3490dcf10ffb6530c1303522a1405dfaf7daecd8f38d3d9b7c2c1ea37ab40c8",
+			"created_at": "2019-01-06T16:49:51.272Z",
+			"total_size": 350224384
+		}`)
+	})
+
+	tag, _, err := client.ContainerRegistry.GetRegistryRepositoryTagDetail(5, 2, "v10.0.0")
+	require.NoError(t, err)
+
+	createdAt := time.Date(2019, time.January, 6, 16, 49, 51, 272000000, time.UTC)
+	want := &RegistryRepositoryTag{
+		Name:          "v10.0.0",
+		Path:          "group/project:v10.0.0",
+		Location:      "gitlab.example.com:5000/group/project:v10.0.0",
+		Revision:      "e9ed9d87c881d8c2fd3a31b41904d01ba0b836e7fd15240d774d811a1c248181",
+		ShortRevision: "e9ed9d87c",
+		Digest:        "sha256:c3490dcf10ffb6530c1303522a1405dfaf7daecd8f38d3d9b7c2c1ea37ab40c8",
+		CreatedAt:     &createdAt,
+		TotalSize:     350224384,
+	}
+	assert.Equal(t, want, tag)
+}
+
+func TestDeleteRegistryRepositoryTags(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/registry/repositories/2/tags", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		testURL(t, r, "/api/v4/projects/5/registry/repositories/2/tags?keep_n=5&name_regex_delete=.%2A&name_regex_keep=stable&older_than=1d")
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	opt := &DeleteRegistryRepositoryTagsOptions{
+		NameRegexpDelete: String(".*"),
+		NameRegexpKeep:   String("stable"),
+		KeepN:            Int(5),
+		OlderThan:        String("1d"),
+	}
+	resp, err := client.ContainerRegistry.DeleteRegistryRepositoryTags(5, 2, opt)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
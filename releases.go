@@ -111,8 +111,10 @@ type ReleaseAssets struct {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/releases/index.html#create-a-release
 type ReleaseAssetLink struct {
-	Name string `url:"name" json:"name"`
-	URL  string `url:"url" json:"url"`
+	Name            string         `url:"name" json:"name"`
+	URL             string         `url:"url" json:"url"`
+	DirectAssetPath *string        `url:"direct_asset_path,omitempty" json:"direct_asset_path,omitempty"`
+	LinkType        *LinkTypeValue `url:"link_type,omitempty" json:"link_type,omitempty"`
 }
 
 // CreateReleaseOptions represents CreateRelease() options.
@@ -138,6 +140,19 @@ func (s *ReleasesService) CreateRelease(pid interface{}, opts *CreateReleaseOpti
 	}
 	u := fmt.Sprintf("projects/%s/releases", pathEscape(project))
 
+	if opts != nil && opts.Assets != nil {
+		for _, link := range opts.Assets.Links {
+			if link.LinkType == nil {
+				continue
+			}
+			switch *link.LinkType {
+			case ImageLinkType, OtherLinkType, PackageLinkType, RunbookLinkType:
+			default:
+				return nil, nil, fmt.Errorf("invalid link type %q for asset link %q", *link.LinkType, link.Name)
+			}
+		}
+	}
+
 	req, err := s.client.NewRequest("POST", u, opts, options)
 	if err != nil {
 		return nil, nil, err
@@ -260,7 +260,7 @@ func TestReleasesService_CreateReleaseWithAsset(t *testing.T) {
 		Description: String("Description"),
 		Assets: &ReleaseAssets{
 			Links: []*ReleaseAssetLink{
-				{"sldkf", "sldkfj"},
+				{Name: "sldkf", URL: "sldkfj"},
 			},
 		},
 	}
@@ -318,3 +318,44 @@ func TestReleasesService_DeleteRelease(t *testing.T) {
 	}
 
 }
+
+func TestReleasesService_CreateReleaseWithAssetLinkType(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/releases",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, "POST")
+			testBody(t, r, `{"name":"name","tag_name":"v0.1","description":"Description","assets":{"links":[{"name":"binary","url":"https://example.com/bin","direct_asset_path":"/bin/app","link_type":"package"}]}}`)
+			fmt.Fprint(w, exampleReleaseRsp)
+		})
+
+	opts := &CreateReleaseOptions{
+		Name:        String("name"),
+		TagName:     String("v0.1"),
+		Description: String("Description"),
+		Assets: &ReleaseAssets{
+			Links: []*ReleaseAssetLink{
+				{
+					Name:            "binary",
+					URL:             "https://example.com/bin",
+					DirectAssetPath: String("/bin/app"),
+					LinkType:        LinkType(PackageLinkType),
+				},
+			},
+		},
+	}
+
+	release, _, err := client.Releases.CreateRelease(1, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if release.TagName != "v0.1" {
+		t.Errorf("expected tag v0.1, got %s", release.TagName)
+	}
+
+	opts.Assets.Links[0].LinkType = LinkType("binary")
+	if _, _, err := client.Releases.CreateRelease(1, opts); err == nil {
+		t.Error("expected an error for an invalid link type")
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
 )
 
@@ -48,13 +49,20 @@ func (t TreeNode) String() string {
 
 // ListTreeOptions represents the available ListTree() options.
 //
+// Offset pagination is limited by GitLab for large trees, recursive listings
+// of big repositories should set Pagination to "keyset". In that case the
+// Response.NextLink field contains the link to the next page, which can be
+// followed using the WithKeysetPaginationParameters request option.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#list-repository-tree
 type ListTreeOptions struct {
 	ListOptions
-	Path      *string `url:"path,omitempty" json:"path,omitempty"`
-	Ref       *string `url:"ref,omitempty" json:"ref,omitempty"`
-	Recursive *bool   `url:"recursive,omitempty" json:"recursive,omitempty"`
+	Path       *string `url:"path,omitempty" json:"path,omitempty"`
+	Ref        *string `url:"ref,omitempty" json:"ref,omitempty"`
+	Recursive  *bool   `url:"recursive,omitempty" json:"recursive,omitempty"`
+	Pagination *string `url:"pagination,omitempty" json:"pagination,omitempty"`
+	PageToken  *string `url:"page_token,omitempty" json:"page_token,omitempty"`
 }
 
 // ListTree gets a list of repository files and directories in a project.
@@ -135,36 +143,26 @@ func (s *RepositoriesService) RawBlobContent(pid interface{}, sha string, option
 
 // ArchiveOptions represents the available Archive() options.
 //
+// Format selects the archive type, one of tar.gz, tar.bz2, tbz, tbz2, tb2,
+// bz2, tar or zip. Path limits the archive to a subdirectory of the
+// repository.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
 type ArchiveOptions struct {
 	Format *string `url:"-" json:"-"`
 	SHA    *string `url:"sha,omitempty" json:"sha,omitempty"`
+	Path   *string `url:"path,omitempty" json:"path,omitempty"`
 }
 
-// Archive gets an archive of the repository.
+// Archive gets an archive of the repository. The complete archive is kept in
+// memory, use ArchiveToWriter for large repositories.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
 func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, options ...RequestOptionFunc) ([]byte, *Response, error) {
-	project, err := parseID(pid)
-	if err != nil {
-		return nil, nil, err
-	}
-	u := fmt.Sprintf("projects/%s/repository/archive", pathEscape(project))
-
-	// Set an optional format for the archive.
-	if opt != nil && opt.Format != nil {
-		u = fmt.Sprintf("%s.%s", u, *opt.Format)
-	}
-
-	req, err := s.client.NewRequest("GET", u, opt, options)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	var b bytes.Buffer
-	resp, err := s.client.Do(req, &b)
+	resp, err := s.ArchiveToWriter(pid, opt, &b, options...)
 	if err != nil {
 		return nil, resp, err
 	}
@@ -172,12 +170,14 @@ func (s *RepositoriesService) Archive(pid interface{}, opt *ArchiveOptions, opti
 	return b.Bytes(), resp, err
 }
 
-// StreamArchive streams an archive of the repository to the provided
-// io.Writer.
+// ArchiveToWriter streams an archive of the repository to w as it arrives,
+// without buffering it in memory.
+//
+// If the requested SHA does not exist ErrRefNotFound is returned.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
-func (s *RepositoriesService) StreamArchive(pid interface{}, w io.Writer, opt *ArchiveOptions, options ...RequestOptionFunc) (*Response, error) {
+func (s *RepositoriesService) ArchiveToWriter(pid interface{}, opt *ArchiveOptions, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
 	project, err := parseID(pid)
 	if err != nil {
 		return nil, err
@@ -194,11 +194,30 @@ func (s *RepositoriesService) StreamArchive(pid interface{}, w io.Writer, opt *A
 		return nil, err
 	}
 
-	return s.client.Do(req, w)
+	resp, err := s.client.Do(req, w)
+	if err != nil && resp != nil && resp.StatusCode == http.StatusNotFound && opt != nil && opt.SHA != nil {
+		return resp, s.client.Commits.refNotFound(pid, *opt.SHA, nil, err, options)
+	}
+
+	return resp, err
+}
+
+// StreamArchive streams an archive of the repository to the provided
+// io.Writer. It is equivalent to ArchiveToWriter.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repositories.html#get-file-archive
+func (s *RepositoriesService) StreamArchive(pid interface{}, w io.Writer, opt *ArchiveOptions, options ...RequestOptionFunc) (*Response, error) {
+	return s.ArchiveToWriter(pid, opt, w, options...)
 }
 
 // Compare represents the result of a comparison of branches, tags or commits.
 //
+// GitLab limits the size of the returned diffs. CompareTimeout is set when
+// GitLab gave up computing the comparison, in which case Commits and Diffs
+// are incomplete and should not be relied upon. CompareSameRef is set when
+// both refs point to the same commit.
+//
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#compare-branches-tags-or-commits
 type Compare struct {
@@ -218,9 +237,11 @@ func (c Compare) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repositories.html#compare-branches-tags-or-commits
 type CompareOptions struct {
-	From     *string `url:"from,omitempty" json:"from,omitempty"`
-	To       *string `url:"to,omitempty" json:"to,omitempty"`
-	Straight *bool   `url:"straight,omitempty" json:"straight,omitempty"`
+	From          *string `url:"from,omitempty" json:"from,omitempty"`
+	To            *string `url:"to,omitempty" json:"to,omitempty"`
+	FromProjectID *int    `url:"from_project_id,omitempty" json:"from_project_id,omitempty"`
+	Straight      *bool   `url:"straight,omitempty" json:"straight,omitempty"`
+	Unidiff       *bool   `url:"unidiff,omitempty" json:"unidiff,omitempty"`
 }
 
 // Compare compares branches, tags or commits.
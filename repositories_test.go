@@ -0,0 +1,143 @@
+package gitlab
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/compare?from=main&straight=true&to=release&unidiff=true")
+		fmt.Fprint(w, `{
+			"commit": {"id": "12d65c8dd2b2676fa3ac47d955accc085a37a9c1"},
+			"commits": [{"id": "12d65c8dd2b2676fa3ac47d955accc085a37a9c1"}],
+			"diffs": [{"old_path": "files/js/application.js", "new_path": "files/js/application.js", "diff": "@@ -24,8 +24,10 @@"}],
+			"compare_timeout": false,
+			"compare_same_ref": false
+		}`)
+	})
+
+	opt := &CompareOptions{
+		From:     String("main"),
+		To:       String("release"),
+		Straight: Bool(true),
+		Unidiff:  Bool(true),
+	}
+	compare, _, err := client.Repositories.Compare(1, opt)
+	require.NoError(t, err)
+
+	want := &Compare{
+		Commit:  &Commit{ID: "12d65c8dd2b2676fa3ac47d955accc085a37a9c1"},
+		Commits: []*Commit{{ID: "12d65c8dd2b2676fa3ac47d955accc085a37a9c1"}},
+		Diffs: []*Diff{{
+			OldPath: "files/js/application.js",
+			NewPath: "files/js/application.js",
+			Diff:    "@@ -24,8 +24,10 @@",
+		}},
+	}
+	assert.Equal(t, want, compare)
+}
+
+func TestCompareTimeout(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"commit": null, "commits": [], "diffs": [], "compare_timeout": true, "compare_same_ref": false}`)
+	})
+
+	compare, _, err := client.Repositories.Compare(1, &CompareOptions{From: String("v1"), To: String("v2")})
+	require.NoError(t, err)
+	assert.True(t, compare.CompareTimeout)
+	assert.False(t, compare.CompareSameRef)
+}
+
+func TestArchiveToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.tar.gz", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/1/repository/archive.tar.gz?path=docs&sha=main")
+		fmt.Fprint(w, "archive content")
+	})
+
+	var b bytes.Buffer
+	opt := &ArchiveOptions{
+		Format: String("tar.gz"),
+		SHA:    String("main"),
+		Path:   String("docs"),
+	}
+	_, err := client.Repositories.ArchiveToWriter(1, opt, &b)
+	require.NoError(t, err)
+	assert.Equal(t, "archive content", b.String())
+}
+
+func TestArchiveToWriterRefNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/archive.zip", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/1/repository/commits/unknown", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message":"404 Commit Not Found"}`)
+	})
+
+	var b bytes.Buffer
+	opt := &ArchiveOptions{Format: String("zip"), SHA: String("unknown")}
+	resp, err := client.Repositories.ArchiveToWriter(1, opt, &b)
+	assert.True(t, errors.Is(err, ErrRefNotFound), "expected ErrRefNotFound, got %v", err)
+	var errResp *ErrorResponse
+	assert.True(t, errors.As(err, &errResp))
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestListTreeRecursiveKeyset(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/tree", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			testURL(t, r, "/api/v4/projects/1/repository/tree?pagination=keyset&path=src&per_page=1&recursive=true")
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v4/projects/1/repository/tree?page_token=a1b2&pagination=keyset&path=src&per_page=1&recursive=true>; rel="next"`, server.URL))
+			fmt.Fprint(w, `[{"id":"a1b2","name":"main.go","type":"blob","path":"src/main.go","mode":"100644"}]`)
+		case "a1b2":
+			fmt.Fprint(w, `[{"id":"c3d4","name":"util.go","type":"blob","path":"src/util.go","mode":"100644"}]`)
+		}
+	})
+
+	opt := &ListTreeOptions{
+		ListOptions: ListOptions{PerPage: 1},
+		Path:        String("src"),
+		Recursive:   Bool(true),
+		Pagination:  String("keyset"),
+	}
+	nodes, resp, err := client.Repositories.ListTree(1, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*TreeNode{{ID: "a1b2", Name: "main.go", Type: "blob", Path: "src/main.go", Mode: "100644"}}, nodes)
+	require.NotEmpty(t, resp.NextLink)
+
+	nodes, resp, err = client.Repositories.ListTree(1, opt, WithKeysetPaginationParameters(resp.NextLink))
+	require.NoError(t, err)
+	assert.Equal(t, []*TreeNode{{ID: "c3d4", Name: "util.go", Type: "blob", Path: "src/util.go", Mode: "100644"}}, nodes)
+	assert.Empty(t, resp.NextLink)
+}
@@ -18,12 +18,20 @@ package gitlab
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"strconv"
 	"time"
 )
 
+var (
+	// ErrFileNotFound is returned when the requested file does not exist in
+	// the repository at the given ref.
+	ErrFileNotFound = errors.New("File does not exist")
+)
+
 // RepositoryFilesService handles communication with the repository files
 // related methods of the GitLab API.
 //
@@ -172,11 +180,14 @@ func (b FileBlameRange) String() string {
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#get-file-blame-from-repository
 type GetFileBlameOptions struct {
-	Ref *string `url:"ref,omitempty" json:"ref,omitempty"`
+	Ref        *string `url:"ref,omitempty" json:"ref,omitempty"`
+	RangeStart *int    `url:"range[start],omitempty" json:"range[start],omitempty"`
+	RangeEnd   *int    `url:"range[end],omitempty" json:"range[end],omitempty"`
 }
 
 // GetFileBlame allows you to receive blame information. Each blame range
-// contains lines and corresponding commit info.
+// contains lines and corresponding commit info. Use the RangeStart and
+// RangeEnd options to only get the blame information of the given lines.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/repository_files.html#get-file-blame-from-repository
@@ -211,6 +222,7 @@ func (s *RepositoryFilesService) GetFileBlame(pid interface{}, file string, opt
 // https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
 type GetRawFileOptions struct {
 	Ref *string `url:"ref,omitempty" json:"ref,omitempty"`
+	LFS *bool   `url:"lfs,omitempty" json:"lfs,omitempty"`
 }
 
 // GetRawFile allows you to receive the raw file in repository.
@@ -242,6 +254,44 @@ func (s *RepositoryFilesService) GetRawFile(pid interface{}, fileName string, op
 	return f.Bytes(), resp, err
 }
 
+// GetRawFileToWriter streams the raw file in the repository to w, without
+// buffering the content in memory. Set the LFS option to receive the actual
+// LFS object instead of the LFS pointer for files tracked by Git LFS.
+//
+// If the ref does not exist ErrRefNotFound is returned, if the ref exists
+// but the file does not ErrFileNotFound is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/repository_files.html#get-raw-file-from-repository
+func (s *RepositoryFilesService) GetRawFileToWriter(pid interface{}, fileName string, w io.Writer, opt *GetRawFileOptions, options ...RequestOptionFunc) (*Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/repository/files/%s/raw",
+		pathEscape(project),
+		url.PathEscape(fileName),
+	)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, w)
+	if err != nil {
+		// Without a ref the file is read from the default branch, which
+		// does not exist in an empty repository.
+		if opt == nil || opt.Ref == nil {
+			return resp, probeNotFound(err, s.client.Commits.probeRef(pid, "HEAD", options), ErrFileNotFound, nil)
+		}
+		return resp, s.client.Commits.refNotFound(pid, *opt.Ref, ErrFileNotFound, err, options)
+	}
+
+	return resp, err
+}
+
 // FileInfo represents file details of a GitLab repository file.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/repository_files.html
@@ -0,0 +1,126 @@
+package gitlab
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFileBlame(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/path/to/file.rb/blame", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/13083/repository/files/path%2Fto%2Ffile.rb/blame?range%5Bend%5D=2&range%5Bstart%5D=1&ref=master")
+		fmt.Fprint(w, `[
+			{
+				"commit": {
+					"id": "d42409d56517157c48bf3bd97d3f75974dde19fb",
+					"message": "Add feature\n\nalso fix bug\n",
+					"parent_ids": ["cc6e14f9328fa6d7b5a0d3c30dc2002a3f2a3822"],
+					"authored_date": "2015-12-18T08:12:22.000Z",
+					"author_name": "John Doe",
+					"author_email": "john.doe@example.com",
+					"committed_date": "2015-12-18T08:12:22.000Z",
+					"committer_name": "John Doe",
+					"committer_email": "john.doe@example.com"
+				},
+				"lines": ["require 'fileutils'", "require 'open3'"]
+			}
+		]`)
+	})
+
+	opt := &GetFileBlameOptions{
+		Ref:        String("master"),
+		RangeStart: Int(1),
+		RangeEnd:   Int(2),
+	}
+	ranges, _, err := client.RepositoryFiles.GetFileBlame(13083, "path/to/file.rb", opt)
+	require.NoError(t, err)
+	require.Len(t, ranges, 1)
+
+	date := time.Date(2015, time.December, 18, 8, 12, 22, 0, time.UTC)
+	commit := ranges[0].Commit
+	assert.Equal(t, "d42409d56517157c48bf3bd97d3f75974dde19fb", commit.ID)
+	assert.Equal(t, []string{"cc6e14f9328fa6d7b5a0d3c30dc2002a3f2a3822"}, commit.ParentIDs)
+	assert.Equal(t, "Add feature\n\nalso fix bug\n", commit.Message)
+	assert.Equal(t, &date, commit.AuthoredDate)
+	assert.Equal(t, "John Doe", commit.AuthorName)
+	assert.Equal(t, "john.doe@example.com", commit.AuthorEmail)
+	assert.Equal(t, &date, commit.CommittedDate)
+	assert.Equal(t, "John Doe", commit.CommitterName)
+	assert.Equal(t, "john.doe@example.com", commit.CommitterEmail)
+	assert.Equal(t, []string{"require 'fileutils'", "require 'open3'"}, ranges[0].Lines)
+}
+
+func TestGetRawFileToWriter(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/assets/logo.png/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/13083/repository/files/assets%2Flogo.png/raw?lfs=true&ref=main")
+		fmt.Fprint(w, "binary content")
+	})
+
+	var buf bytes.Buffer
+	opt := &GetRawFileOptions{Ref: String("main"), LFS: Bool(true)}
+	_, err := client.RepositoryFiles.GetRawFileToWriter(13083, "assets/logo.png", &buf, opt)
+	require.NoError(t, err)
+	assert.Equal(t, "binary content", buf.String())
+}
+
+func TestGetRawFileToWriterNotFound(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/13083/repository/files/missing.txt/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		w.WriteHeader(http.StatusNotFound)
+		if r.URL.Query().Get("ref") == "missing" {
+			fmt.Fprint(w, `{"message": "404 Commit Not Found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"message": "404 File Not Found"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/13083/repository/commits/main", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": "7b5c3cc8be40ee161ae89a06bba6229da1032a0c"}`)
+	})
+	mux.HandleFunc("/api/v4/projects/13083/repository/commits/HEAD", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": "7b5c3cc8be40ee161ae89a06bba6229da1032a0c"}`)
+	})
+
+	tests := []struct {
+		ref  string
+		want error
+	}{
+		{ref: "main", want: ErrFileNotFound},
+		{ref: "missing", want: ErrRefNotFound},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		resp, err := client.RepositoryFiles.GetRawFileToWriter(13083, "missing.txt", &buf, &GetRawFileOptions{Ref: String(tt.ref)})
+		assert.True(t, errors.Is(err, tt.want), "expected %v, got %v", tt.want, err)
+		var errResp *ErrorResponse
+		if assert.True(t, errors.As(err, &errResp)) {
+			assert.Equal(t, http.StatusNotFound, errResp.Response.StatusCode)
+		}
+		require.NotNil(t, resp)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	}
+
+	// Without a ref the file is read from the default branch.
+	var buf bytes.Buffer
+	_, err := client.RepositoryFiles.GetRawFileToWriter(13083, "missing.txt", &buf, nil)
+	assert.True(t, errors.Is(err, ErrFileNotFound), "expected %v, got %v", ErrFileNotFound, err)
+}
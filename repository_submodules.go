@@ -0,0 +1,65 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrSubmoduleUpdateRejected is returned by UpdateSubmodule when GitLab
+// rejects the update, for example because the commit SHA is invalid or does
+// not exist in the upstream repository of the submodule. Use errors.Is to
+// check for it, the ErrorResponse of GitLab can still be obtained using
+// errors.As.
+var ErrSubmoduleUpdateRejected = errors.New("Submodule update was rejected")
+
+// RepositorySubmodulesService handles communication with the repository
+// submodules related methods of the GitLab API.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_submodules.html
+type RepositorySubmodulesService struct {
+	client *Client
+}
+
+// UpdateSubmoduleOptions represents the available UpdateSubmodule() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_submodules.html#update-existing-submodule-reference-in-repository
+type UpdateSubmoduleOptions struct {
+	Branch        *string `url:"branch,omitempty" json:"branch,omitempty"`
+	CommitSHA     *string `url:"commit_sha,omitempty" json:"commit_sha,omitempty"`
+	CommitMessage *string `url:"commit_message,omitempty" json:"commit_message,omitempty"`
+}
+
+// UpdateSubmodule updates the commit a submodule points to and returns the
+// commit created on the branch. The submodule is the path of the submodule
+// within the repository. ErrSubmoduleUpdateRejected is returned when GitLab
+// rejects the given commit SHA.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/repository_submodules.html#update-existing-submodule-reference-in-repository
+func (s *RepositorySubmodulesService) UpdateSubmodule(pid interface{}, submodule string, opt *UpdateSubmoduleOptions, options ...RequestOptionFunc) (*Commit, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf(
+		"projects/%s/repository/submodules/%s",
+		pathEscape(project),
+		pathEscape(submodule),
+	)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := new(Commit)
+	resp, err := s.client.Do(req, c)
+	if err != nil {
+		return nil, resp, wrapErrorResponse(err, http.StatusBadRequest, "", ErrSubmoduleUpdateRejected)
+	}
+
+	return c, resp, err
+}
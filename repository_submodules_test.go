@@ -0,0 +1,67 @@
+package gitlab
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateSubmodule(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testURL(t, r, "/api/v4/projects/13083/repository/submodules/lib%2Fmodules%2Fexample")
+		testBody(t, r, `{"branch":"main","commit_sha":"3ddec28ea23acc5caa5d8331a6ecb2a65fc03e88","commit_message":"Update submodule reference"}`)
+		fmt.Fprint(w, `{
+			"id": "ed899a2f4b50b4370feeea94676502b42383c746",
+			"short_id": "ed899a2f4b5",
+			"title": "Update submodule reference",
+			"message": "Update submodule reference",
+			"parent_ids": ["4ea3aa8daae8d8c3b6ed8d38b5d9e6b0b3bc3fa8"]
+		}`)
+	})
+
+	opt := &UpdateSubmoduleOptions{
+		Branch:        String("main"),
+		CommitSHA:     String("3ddec28ea23acc5caa5d8331a6ecb2a65fc03e88"),
+		CommitMessage: String("Update submodule reference"),
+	}
+	commit, _, err := client.RepositorySubmodules.UpdateSubmodule(13083, "lib/modules/example", opt)
+	require.NoError(t, err)
+
+	want := &Commit{
+		ID:        "ed899a2f4b50b4370feeea94676502b42383c746",
+		ShortID:   "ed899a2f4b5",
+		Title:     "Update submodule reference",
+		Message:   "Update submodule reference",
+		ParentIDs: []string{"4ea3aa8daae8d8c3b6ed8d38b5d9e6b0b3bc3fa8"},
+	}
+	assert.Equal(t, want, commit)
+}
+
+func TestUpdateSubmoduleRejected(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/repository/submodules/example", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"Invalid parameters"}`)
+	})
+
+	opt := &UpdateSubmoduleOptions{Branch: String("main"), CommitSHA: String("unknown")}
+	commit, resp, err := client.RepositorySubmodules.UpdateSubmodule(1, "example", opt)
+	assert.True(t, errors.Is(err, ErrSubmoduleUpdateRejected), "expected ErrSubmoduleUpdateRejected, got %v", err)
+	var errResp *ErrorResponse
+	require.True(t, errors.As(err, &errResp))
+	assert.Contains(t, errResp.Message, "Invalid parameters")
+	assert.Nil(t, commit)
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -2,6 +2,9 @@ package gitlab
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 )
@@ -21,6 +24,59 @@ func WithSudo(uid interface{}) RequestOptionFunc {
 	}
 }
 
+// retryableKey is the context key used to mark a request as retryable.
+type retryableKey struct{}
+
+// WithRetryable marks the request as retryable when a retry policy is
+// configured using WithRetry, even if it does not use a safe method. Note
+// that WithContext replaces the context of the request, so it should be
+// passed before this option.
+func WithRetryable() RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		*req = *req.WithContext(context.WithValue(req.Context(), retryableKey{}, true))
+		return nil
+	}
+}
+
+// WithRequestBaseURL overrides the base URL of the client for a single request,
+// which allows reusing a client for requests to another GitLab instance. The
+// URL is handled the same way as the one passed to WithBaseURL.
+func WithRequestBaseURL(urlStr string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		baseURL, err := parseBaseURL(urlStr)
+		if err != nil {
+			return err
+		}
+		if baseURL.Scheme == "" || baseURL.Host == "" {
+			return fmt.Errorf("invalid base URL %q, the URL must be absolute", urlStr)
+		}
+
+		// The request path always starts with the path of the client base URL,
+		// which ends with the API version path.
+		i := strings.Index(req.URL.Path, apiVersionPath)
+		if i < 0 {
+			return fmt.Errorf("unexpected request path %q", req.URL.Path)
+		}
+		path := req.URL.Path[i+len(apiVersionPath):]
+
+		var rawPath string
+		if req.URL.RawPath != "" {
+			if i := strings.Index(req.URL.RawPath, apiVersionPath); i >= 0 {
+				rawPath = baseURL.Path + req.URL.RawPath[i+len(apiVersionPath):]
+			}
+		}
+
+		req.URL.Scheme = baseURL.Scheme
+		req.URL.Host = baseURL.Host
+		req.URL.User = baseURL.User
+		req.URL.Path = baseURL.Path + path
+		req.URL.RawPath = rawPath
+		req.Host = baseURL.Host
+
+		return nil
+	}
+}
+
 // WithContext runs the request with the provided context
 func WithContext(ctx context.Context) RequestOptionFunc {
 	return func(req *retryablehttp.Request) error {
@@ -28,3 +84,17 @@ func WithContext(ctx context.Context) RequestOptionFunc {
 		return nil
 	}
 }
+
+// WithKeysetPaginationParameters takes a "next" link from the Link header of
+// a response to a keyset-paginated request and modifies the request to fetch
+// the next page of results.
+func WithKeysetPaginationParameters(nextLink string) RequestOptionFunc {
+	return func(req *retryablehttp.Request) error {
+		nextURL, err := url.Parse(nextLink)
+		if err != nil {
+			return err
+		}
+		req.URL.RawQuery = nextURL.RawQuery
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ResourceIterationEventsService handles communication with the event related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/resource_iteration_events.html
+type ResourceIterationEventsService struct {
+	client *Client
+}
+
+// IterationEvent represents a resource iteration event.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/resource_iteration_events.html#list-project-issue-iteration-events
+type IterationEvent struct {
+	ID           int        `json:"id"`
+	User         *BasicUser `json:"user"`
+	CreatedAt    *time.Time `json:"created_at"`
+	ResourceType string     `json:"resource_type"`
+	ResourceID   int        `json:"resource_id"`
+	Iteration    *Iteration `json:"iteration"`
+	Action       string     `json:"action"`
+}
+
+// ListIterationEventsOptions represents the options for all resource iteration
+// events list methods.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/resource_iteration_events.html#list-project-issue-iteration-events
+type ListIterationEventsOptions struct {
+	ListOptions
+}
+
+// ListIssueIterationEvents retrieves resource iteration events for the
+// specified project and issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/resource_iteration_events.html#list-project-issue-iteration-events
+func (s *ResourceIterationEventsService) ListIssueIterationEvents(pid interface{}, issue int, opt *ListIterationEventsOptions, options ...RequestOptionFunc) ([]*IterationEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d/resource_iteration_events", pathEscape(project), issue)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ies []*IterationEvent
+	resp, err := s.client.Do(req, &ies)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ies, resp, err
+}
@@ -0,0 +1,42 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIssueIterationEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/issues/11/resource_iteration_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[
+			{
+				"id": 142,
+				"user": {"id": 1, "name": "Administrator", "username": "root"},
+				"resource_type": "Issue",
+				"resource_id": 11,
+				"iteration": {"id": 50, "iid": 9, "group_id": 5, "title": "Sprint 1", "state": 2},
+				"action": "add"
+			}
+		]`)
+	})
+
+	events, _, err := client.ResourceIterationEvents.ListIssueIterationEvents(5, 11, nil)
+	require.NoError(t, err)
+
+	want := []*IterationEvent{{
+		ID:           142,
+		User:         &BasicUser{ID: 1, Name: "Administrator", Username: "root"},
+		ResourceType: "Issue",
+		ResourceID:   11,
+		Iteration:    &Iteration{ID: 50, IID: 9, GroupID: 5, Title: "Sprint 1", State: 2},
+		Action:       "add",
+	}}
+	assert.Equal(t, want, events)
+}
@@ -0,0 +1,73 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListGroupEpicLabelEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/11/resource_label_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/1/epics/11/resource_label_events?page=2&per_page=1")
+		fmt.Fprint(w, `[
+			{
+				"id": 106,
+				"user": {"id": 1, "name": "Administrator", "username": "root", "state": "active"},
+				"created_at": "2018-08-19T11:43:01.746Z",
+				"resource_type": "Epic",
+				"resource_id": 33,
+				"label": {"id": 73, "name": "a1", "color": "#34495E", "description": ""},
+				"action": "add"
+			}
+		]`)
+	})
+
+	opt := &ListLabelEventsOptions{ListOptions{Page: 2, PerPage: 1}}
+	events, _, err := client.ResourceLabelEvents.ListGroupEpicLabelEvents(1, 11, opt)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	createdAt := time.Date(2018, time.August, 19, 11, 43, 1, 746000000, time.UTC)
+	e := events[0]
+	assert.Equal(t, 106, e.ID)
+	assert.Equal(t, "add", e.Action)
+	assert.Equal(t, &createdAt, e.CreatedAt)
+	assert.Equal(t, "Epic", e.ResourceType)
+	assert.Equal(t, 33, e.ResourceID)
+	assert.Equal(t, "root", e.User.Username)
+	assert.Equal(t, 73, e.Label.ID)
+	assert.Equal(t, "a1", e.Label.Name)
+}
+
+func TestGetGroupEpicLabelEvent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/1/epics/11/resource_label_events/107", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"id": 107,
+			"resource_type": "Epic",
+			"resource_id": 33,
+			"label": {"id": 73, "name": "a1"},
+			"action": "remove"
+		}`)
+	})
+
+	event, _, err := client.ResourceLabelEvents.GetGroupEpicLabelEvent(1, 11, 107)
+	require.NoError(t, err)
+
+	assert.Equal(t, 107, event.ID)
+	assert.Equal(t, "remove", event.Action)
+	assert.Equal(t, "Epic", event.ResourceType)
+	assert.Equal(t, 33, event.ResourceID)
+	assert.Equal(t, "a1", event.Label.Name)
+}
@@ -0,0 +1,62 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIssueStateEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/issues/11/resource_state_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/issues/11/resource_state_events?page=2&per_page=1")
+		fmt.Fprint(w, `[
+			{
+				"id": 142,
+				"user": {"id": 1, "name": "Administrator", "username": "root", "state": "active"},
+				"created_at": "2018-08-20T13:38:20.077Z",
+				"resource_type": "Issue",
+				"resource_id": 11,
+				"state": "opened"
+			}
+		]`)
+	})
+
+	opt := &ListStateEventsOptions{ListOptions{Page: 2, PerPage: 1}}
+	events, _, err := client.ResourceStateEvents.ListIssueStateEvents(5, 11, opt)
+	require.NoError(t, err)
+
+	createdAt := time.Date(2018, time.August, 20, 13, 38, 20, 77000000, time.UTC)
+	want := []*StateEvent{{
+		ID:           142,
+		User:         &BasicUser{ID: 1, Name: "Administrator", Username: "root", State: "active"},
+		CreatedAt:    &createdAt,
+		ResourceType: "Issue",
+		ResourceID:   11,
+		State:        "opened",
+	}}
+	assert.Equal(t, want, events)
+}
+
+func TestGetMergeRequestStateEvent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/merge_requests/11/resource_state_events/143", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id": 143, "resource_type": "MergeRequest", "resource_id": 11, "state": "closed"}`)
+	})
+
+	event, _, err := client.ResourceStateEvents.GetMergeRequestStateEvent(5, 11, 143)
+	require.NoError(t, err)
+
+	want := &StateEvent{ID: 143, ResourceType: "MergeRequest", ResourceID: 11, State: ClosedEventType}
+	assert.Equal(t, want, event)
+}
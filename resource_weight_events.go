@@ -0,0 +1,66 @@
+package gitlab
+
+import (
+	"fmt"
+	"time"
+)
+
+// ResourceWeightEventsService handles communication with the event related
+// methods of the GitLab API.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/resource_weight_events.html
+type ResourceWeightEventsService struct {
+	client *Client
+}
+
+// WeightEvent represents a resource weight event. GitLab only reports the
+// weight the issue was changed to, the previous weight is the weight of the
+// preceding event, if any.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/resource_weight_events.html#list-project-issue-weight-events
+type WeightEvent struct {
+	ID           int        `json:"id"`
+	User         *BasicUser `json:"user"`
+	CreatedAt    *time.Time `json:"created_at"`
+	ResourceType string     `json:"resource_type"`
+	ResourceID   int        `json:"resource_id"`
+	State        string     `json:"state"`
+	IssueID      int        `json:"issue_id"`
+	Weight       *int       `json:"weight"`
+}
+
+// ListWeightEventsOptions represents the options for all resource weight events
+// list methods.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/resource_weight_events.html#list-project-issue-weight-events
+type ListWeightEventsOptions struct {
+	ListOptions
+}
+
+// ListIssueWeightEvents retrieves resource weight events for the specified
+// project and issue.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/resource_weight_events.html#list-project-issue-weight-events
+func (s *ResourceWeightEventsService) ListIssueWeightEvents(pid interface{}, issue int, opt *ListWeightEventsOptions, options ...RequestOptionFunc) ([]*WeightEvent, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/issues/%d/resource_weight_events", pathEscape(project), issue)
+
+	req, err := s.client.NewRequest("GET", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wes []*WeightEvent
+	resp, err := s.client.Do(req, &wes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wes, resp, err
+}
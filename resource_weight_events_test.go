@@ -0,0 +1,58 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListIssueWeightEvents(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/issues/253/resource_weight_events", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/issues/253/resource_weight_events?page=1&per_page=20")
+		fmt.Fprint(w, `[
+			{
+				"id": 142,
+				"user": {"id": 1, "name": "Administrator", "username": "root"},
+				"created_at": "2018-08-20T13:38:20.077Z",
+				"issue_id": 253,
+				"weight": 3
+			},
+			{
+				"id": 143,
+				"created_at": "2018-08-21T14:38:20.077Z",
+				"issue_id": 253,
+				"weight": null
+			}
+		]`)
+	})
+
+	opt := &ListWeightEventsOptions{ListOptions{Page: 1, PerPage: 20}}
+	events, _, err := client.ResourceWeightEvents.ListIssueWeightEvents(5, 253, opt)
+	require.NoError(t, err)
+
+	first := time.Date(2018, time.August, 20, 13, 38, 20, 77000000, time.UTC)
+	second := time.Date(2018, time.August, 21, 14, 38, 20, 77000000, time.UTC)
+	want := []*WeightEvent{
+		{
+			ID:        142,
+			User:      &BasicUser{ID: 1, Name: "Administrator", Username: "root"},
+			CreatedAt: &first,
+			IssueID:   253,
+			Weight:    Int(3),
+		},
+		{
+			ID:        143,
+			CreatedAt: &second,
+			IssueID:   253,
+		},
+	}
+	assert.Equal(t, want, events)
+}
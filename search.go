@@ -28,10 +28,16 @@ type SearchService struct {
 	client *Client
 }
 
-// SearchOptions represents the available options for all search methods.
+// SearchOptions represents the available options for all search methods. The
+// Confidential and State filters are only supported when searching issues or
+// merge requests.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/search.html
-type SearchOptions ListOptions
+type SearchOptions struct {
+	ListOptions
+	Confidential *bool   `url:"confidential,omitempty" json:"confidential,omitempty"`
+	State        *string `url:"state,omitempty" json:"state,omitempty"`
+}
 
 type searchOptions struct {
 	SearchOptions
@@ -39,6 +45,14 @@ type searchOptions struct {
 	Search string `url:"search" json:"search"`
 }
 
+func newSearchOptions(scope, query string, opt *SearchOptions) *searchOptions {
+	opts := &searchOptions{Scope: scope, Search: query}
+	if opt != nil {
+		opts.SearchOptions = *opt
+	}
+	return opts
+}
+
 // Projects searches the expression within projects
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/search.html#scope-projects
@@ -243,6 +257,7 @@ func (s *SearchService) CommitsByProject(pid interface{}, query string, opt *Sea
 type Blob struct {
 	Basename  string `json:"basename"`
 	Data      string `json:"data"`
+	Path      string `json:"path"`
 	Filename  string `json:"filename"`
 	ID        int    `json:"id"`
 	Ref       string `json:"ref"`
@@ -309,7 +324,7 @@ func (s *SearchService) UsersByProject(pid interface{}, query string, opt *Searc
 }
 
 func (s *SearchService) search(scope, query string, result interface{}, opt *SearchOptions, options ...RequestOptionFunc) (*Response, error) {
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := newSearchOptions(scope, query, opt)
 
 	req, err := s.client.NewRequest("GET", "search", opts, options)
 	if err != nil {
@@ -326,7 +341,7 @@ func (s *SearchService) searchByGroup(gid interface{}, scope, query string, resu
 	}
 	u := fmt.Sprintf("groups/%s/-/search", pathEscape(group))
 
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := newSearchOptions(scope, query, opt)
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -343,7 +358,7 @@ func (s *SearchService) searchByProject(pid interface{}, scope, query string, re
 	}
 	u := fmt.Sprintf("projects/%s/-/search", pathEscape(project))
 
-	opts := &searchOptions{SearchOptions: *opt, Scope: scope, Search: query}
+	opts := newSearchOptions(scope, query, opt)
 
 	req, err := s.client.NewRequest("GET", u, opts, options)
 	if err != nil {
@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -16,7 +17,7 @@ func TestSearchService_Users(t *testing.T) {
 		mustWriteHTTPResponse(t, w, "testdata/search_users.json")
 	})
 
-	opts := &SearchOptions{PerPage: 2}
+	opts := &SearchOptions{ListOptions: ListOptions{PerPage: 2}}
 	users, _, err := client.Search.Users("doe", opts)
 
 	require.NoError(t, err)
@@ -40,7 +41,7 @@ func TestSearchService_UsersByGroup(t *testing.T) {
 		mustWriteHTTPResponse(t, w, "testdata/search_users.json")
 	})
 
-	opts := &SearchOptions{PerPage: 2}
+	opts := &SearchOptions{ListOptions: ListOptions{PerPage: 2}}
 	users, _, err := client.Search.UsersByGroup("3", "doe", opts)
 
 	require.NoError(t, err)
@@ -64,7 +65,7 @@ func TestSearchService_UsersByProject(t *testing.T) {
 		mustWriteHTTPResponse(t, w, "testdata/search_users.json")
 	})
 
-	opts := &SearchOptions{PerPage: 2}
+	opts := &SearchOptions{ListOptions: ListOptions{PerPage: 2}}
 	users, _, err := client.Search.UsersByProject("6", "doe", opts)
 
 	require.NoError(t, err)
@@ -78,3 +79,60 @@ func TestSearchService_UsersByProject(t *testing.T) {
 	}}
 	require.Equal(t, want, users)
 }
+
+func TestSearchService_IssuesByProject(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/5/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/projects/5/-/search?confidential=true&scope=issues&search=file&state=opened")
+		fmt.Fprint(w, `[{"id": 83, "iid": 1, "project_id": 5, "title": "Add file", "state": "opened", "confidential": true}]`)
+	})
+
+	opts := &SearchOptions{Confidential: Bool(true), State: String("opened")}
+	issues, _, err := client.Search.IssuesByProject(5, "file", opts)
+
+	require.NoError(t, err)
+
+	want := []*Issue{{ID: 83, IID: 1, ProjectID: 5, Title: "Add file", State: "opened", Confidential: true}}
+	require.Equal(t, want, issues)
+}
+
+func TestSearchService_MergeRequestsWithoutOptions(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/search?scope=merge_requests&search=file")
+		fmt.Fprint(w, `[{"id": 56, "iid": 8, "project_id": 6, "title": "Add first file"}]`)
+	})
+
+	mrs, _, err := client.Search.MergeRequests("file", nil)
+
+	require.NoError(t, err)
+
+	want := []*MergeRequest{{ID: 56, IID: 8, ProjectID: 6, Title: "Add first file"}}
+	require.Equal(t, want, mrs)
+}
+
+func TestSearchService_BlobsByGroup(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/groups/3/-/search", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/groups/3/-/search?scope=blobs&search=installation")
+		fmt.Fprint(w, `[{"basename": "README", "data": "## Installation", "path": "README.md", "filename": "README.md", "ref": "master", "startline": 46, "project_id": 6}]`)
+	})
+
+	blobs, _, err := client.Search.BlobsByGroup(3, "installation", nil)
+
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	require.Equal(t, "README.md", blobs[0].Path)
+	require.Equal(t, "README.md", blobs[0].Filename)
+	require.Equal(t, 46, blobs[0].Startline)
+	require.Equal(t, 6, blobs[0].ProjectID)
+}
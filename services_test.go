@@ -333,3 +333,148 @@ func TestDeleteCustomIssueTrackerService(t *testing.T) {
 		t.Fatalf("Services.DeleteCustomIssueTrackerService returns an error: %v", err)
 	}
 }
+
+func TestListIntegrations(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":75,"title":"Jenkins CI","slug":"jenkins","active":true,"push_events":true}]`)
+	})
+	want := []*Integration{{Service: Service{ID: 75, Title: "Jenkins CI", Slug: "jenkins", Active: true, PushEvents: true}}}
+
+	integrations, _, err := client.Services.ListIntegrations(1)
+	if err != nil {
+		t.Fatalf("Services.ListIntegrations returns an error: %v", err)
+	}
+	if !reflect.DeepEqual(want, integrations) {
+		t.Errorf("Services.ListIntegrations returned %+v, want %+v", integrations, want)
+	}
+}
+
+func TestGetIntegration(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/jira", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"slug":"jira","properties":{"url":"https://jira.example.com","username":"gitlab"}}`)
+	})
+	want := &Integration{
+		Service: Service{ID: 1, Slug: "jira"},
+		Properties: map[string]interface{}{
+			"url":      "https://jira.example.com",
+			"username": "gitlab",
+		},
+	}
+
+	integration, _, err := client.Services.GetIntegration(1, "jira")
+	if err != nil {
+		t.Fatalf("Services.GetIntegration returns an error: %v", err)
+	}
+	if !reflect.DeepEqual(want, integration) {
+		t.Errorf("Services.GetIntegration returned %+v, want %+v", integration, want)
+	}
+}
+
+func TestSetIntegration(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/jira", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"url":"https://jira.example.com","username":"gitlab","password":"secret","jira_issue_transition_id":"2"}`)
+		fmt.Fprint(w, `{"id":1,"slug":"jira","active":true}`)
+	})
+
+	opt := &SetJiraServiceOptions{
+		URL:                   String("https://jira.example.com"),
+		Username:              String("gitlab"),
+		Password:              String("secret"),
+		JiraIssueTransitionID: String("2"),
+	}
+	want := &Integration{Service: Service{ID: 1, Slug: "jira", Active: true}}
+
+	integration, _, err := client.Services.SetIntegration(1, "jira", opt)
+	if err != nil {
+		t.Fatalf("Services.SetIntegration returns an error: %v", err)
+	}
+	if !reflect.DeepEqual(want, integration) {
+		t.Errorf("Services.SetIntegration returned %+v, want %+v", integration, want)
+	}
+}
+
+func TestDeleteIntegration(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/integrations/jira", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Services.DeleteIntegration(1, "jira")
+	if err != nil {
+		t.Fatalf("Services.DeleteIntegration returns an error: %v", err)
+	}
+}
+
+func TestGetMattermostService(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/services/mattermost", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"id":1,"properties":{"webhook":"https://mattermost.example.com/hooks/1","channel":"builds"}}`)
+	})
+	want := &MattermostService{
+		Service: Service{ID: 1},
+		Properties: &MattermostServiceProperties{
+			WebHook: "https://mattermost.example.com/hooks/1",
+			Channel: "builds",
+		},
+	}
+
+	service, _, err := client.Services.GetMattermostService(1)
+	if err != nil {
+		t.Fatalf("Services.GetMattermostService returns an error: %v", err)
+	}
+	if !reflect.DeepEqual(want, service) {
+		t.Errorf("Services.GetMattermostService returned %+v, want %+v", service, want)
+	}
+}
+
+func TestSetMattermostService(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/services/mattermost", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"webhook":"https://mattermost.example.com/hooks/1","channel":"builds","pipeline_events":true}`)
+	})
+
+	opt := &SetMattermostServiceOptions{
+		WebHook:        String("https://mattermost.example.com/hooks/1"),
+		Channel:        String("builds"),
+		PipelineEvents: Bool(true),
+	}
+
+	_, err := client.Services.SetMattermostService(1, opt)
+	if err != nil {
+		t.Fatalf("Services.SetMattermostService returns an error: %v", err)
+	}
+}
+
+func TestDeleteMattermostService(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/services/mattermost", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+	})
+
+	_, err := client.Services.DeleteMattermostService(1)
+	if err != nil {
+		t.Fatalf("Services.DeleteMattermostService returns an error: %v", err)
+	}
+}
@@ -47,24 +47,31 @@ type Settings struct {
 	AssetProxySecretKey                       string            `json:"asset_proxy_secret_key"`
 	AssetProxyURL                             string            `json:"asset_proxy_url"`
 	AssetProxyWhitelist                       []string          `json:"asset_proxy_whitelist"`
-	AuthorizedKeysEnabled                     bool              `json:"authorized_keys_enabled_enabled"`
+	AuthorizedKeysEnabled                     bool              `json:"authorized_keys_enabled"`
 	AutoDevOpsDomain                          string            `json:"auto_devops_domain"`
 	AutoDevOpsEnabled                         bool              `json:"auto_devops_enabled"`
 	CheckNamespacePlan                        bool              `json:"check_namespace_plan"`
 	CommitEmailHostname                       string            `json:"commit_email_hostname"`
 	ContainerRegistryTokenExpireDelay         int               `json:"container_registry_token_expire_delay"`
+	DeactivateDormantUsers                    bool              `json:"deactivate_dormant_users"`
 	DefaultArtifactsExpireIn                  string            `json:"default_artifacts_expire_in"`
+	DefaultBranchName                         string            `json:"default_branch_name"`
 	DefaultBranchProtection                   int               `json:"default_branch_protection"`
 	DefaultGroupVisibility                    VisibilityValue   `json:"default_group_visibility"`
 	DefaultProjectCreation                    int               `json:"default_project_creation"`
 	DefaultProjectsLimit                      int               `json:"default_projects_limit"`
 	DefaultProjectVisibility                  VisibilityValue   `json:"default_project_visibility"`
 	DefaultSnippetVisibility                  VisibilityValue   `json:"default_snippet_visibility"`
+	DeletionAdjournedPeriod                   int               `json:"deletion_adjourned_period"`
 	DiffMaxPatchBytes                         int               `json:"diff_max_patch_bytes"`
 	DisabledOauthSignInSources                []string          `json:"disabled_oauth_sign_in_sources"`
+	DisableFeedToken                          bool              `json:"disable_feed_token"`
 	DNSRebindingProtectionEnabled             bool              `json:"dns_rebinding_protection_enabled"`
+	DomainAllowlist                           []string          `json:"domain_allowlist"`
 	DomainBlacklist                           []string          `json:"domain_blacklist"`
 	DomainBlacklistEnabled                    bool              `json:"domain_blacklist_enabled"`
+	DomainDenylist                            []string          `json:"domain_denylist"`
+	DomainDenylistEnabled                     bool              `json:"domain_denylist_enabled"`
 	DomainWhitelist                           []string          `json:"domain_whitelist"`
 	DSAKeyRestriction                         int               `json:"dsa_key_restriction"`
 	ECDSAKeyRestriction                       int               `json:"ecdsa_key_restriction"`
@@ -115,9 +122,12 @@ type Settings struct {
 	HTMLEmailsEnabled                         bool              `json:"html_emails_enabled"`
 	ImportSources                             []string          `json:"import_sources"`
 	InstanceStatisticsVisibilityPrivate       bool              `json:"instance_statistics_visibility_private"`
+	KeepLatestArtifact                        bool              `json:"keep_latest_artifact"`
 	LocalMarkdownVersion                      int               `json:"local_markdown_version"`
 	MaxArtifactsSize                          int               `json:"max_artifacts_size"`
 	MaxAttachmentSize                         int               `json:"max_attachment_size"`
+	MaxExportSize                             int               `json:"max_export_size"`
+	MaxImportSize                             int               `json:"max_import_size"`
 	MaxPagesSize                              int               `json:"max_pages_size"`
 	MetricsEnabled                            bool              `json:"metrics_enabled"`
 	MetricsHost                               string            `json:"metrics_host"`
@@ -138,6 +148,7 @@ type Settings struct {
 	PerformanceBarAllowedGroupID              string            `json:"performance_bar_allowed_group_id"`
 	PerformanceBarAllowedGroupPath            string            `json:"performance_bar_allowed_group_path"`
 	PerformanceBarEnabled                     bool              `json:"performance_bar_enabled"`
+	PersonalAccessTokenPrefix                 string            `json:"personal_access_token_prefix"`
 	PlantumlEnabled                           bool              `json:"plantuml_enabled"`
 	PlantumlURL                               string            `json:"plantuml_url"`
 	PollingIntervalMultiplier                 float64           `json:"polling_interval_multiplier,string"`
@@ -154,6 +165,7 @@ type Settings struct {
 	RepositoryChecksEnabled                   bool              `json:"repository_checks_enabled"`
 	RepositorySizeLimit                       int               `json:"repository_size_limit"`
 	RepositoryStorages                        []string          `json:"repository_storages"`
+	RequireAdminApprovalAfterUserSignup       bool              `json:"require_admin_approval_after_user_signup"`
 	RequireTwoFactorAuthentication            bool              `json:"require_two_factor_authentication"`
 	RestrictedVisibilityLevels                []VisibilityValue `json:"restricted_visibility_levels"`
 	RsaKeyRestriction                         int               `json:"rsa_key_restriction"`
@@ -245,18 +257,25 @@ type UpdateSettingsOptions struct {
 	CheckNamespacePlan                        *bool             `url:"check_namespace_plan,omitempty" json:"check_namespace_plan,omitempty"`
 	CommitEmailHostname                       *string           `url:"commit_email_hostname,omitempty" json:"commit_email_hostname,omitempty"`
 	ContainerRegistryTokenExpireDelay         *int              `url:"container_registry_token_expire_delay,omitempty" json:"container_registry_token_expire_delay,omitempty"`
+	DeactivateDormantUsers                    *bool             `url:"deactivate_dormant_users,omitempty" json:"deactivate_dormant_users,omitempty"`
 	DefaultArtifactsExpireIn                  *string           `url:"default_artifacts_expire_in,omitempty" json:"default_artifacts_expire_in,omitempty"`
+	DefaultBranchName                         *string           `url:"default_branch_name,omitempty" json:"default_branch_name,omitempty"`
 	DefaultBranchProtection                   *int              `url:"default_branch_protection,omitempty" json:"default_branch_protection,omitempty"`
 	DefaultGroupVisibility                    *VisibilityValue  `url:"default_group_visibility,omitempty" json:"default_group_visibility,omitempty"`
 	DefaultProjectCreation                    *int              `url:"default_project_creation,omitempty" json:"default_project_creation,omitempty"`
 	DefaultProjectsLimit                      *int              `url:"default_projects_limit,omitempty" json:"default_projects_limit,omitempty"`
 	DefaultProjectVisibility                  *VisibilityValue  `url:"default_project_visibility,omitempty" json:"default_project_visibility,omitempty"`
 	DefaultSnippetVisibility                  *VisibilityValue  `url:"default_snippet_visibility,omitempty" json:"default_snippet_visibility,omitempty"`
+	DeletionAdjournedPeriod                   *int              `url:"deletion_adjourned_period,omitempty" json:"deletion_adjourned_period,omitempty"`
 	DiffMaxPatchBytes                         *int              `url:"diff_max_patch_bytes,omitempty" json:"diff_max_patch_bytes,omitempty"`
 	DisabledOauthSignInSources                []string          `url:"disabled_oauth_sign_in_sources,omitempty" json:"disabled_oauth_sign_in_sources,omitempty"`
+	DisableFeedToken                          *bool             `url:"disable_feed_token,omitempty" json:"disable_feed_token,omitempty"`
 	DNSRebindingProtectionEnabled             *bool             `url:"dns_rebinding_protection_enabled,omitempty" json:"dns_rebinding_protection_enabled,omitempty"`
+	DomainAllowlist                           []string          `url:"domain_allowlist,omitempty" json:"domain_allowlist,omitempty"`
 	DomainBlacklist                           []string          `url:"domain_blacklist,omitempty" json:"domain_blacklist,omitempty"`
 	DomainBlacklistEnabled                    *bool             `url:"domain_blacklist_enabled,omitempty" json:"domain_blacklist_enabled,omitempty"`
+	DomainDenylist                            []string          `url:"domain_denylist,omitempty" json:"domain_denylist,omitempty"`
+	DomainDenylistEnabled                     *bool             `url:"domain_denylist_enabled,omitempty" json:"domain_denylist_enabled,omitempty"`
 	DomainWhitelist                           []string          `url:"domain_whitelist,omitempty" json:"domain_whitelist,omitempty"`
 	DSAKeyRestriction                         *int              `url:"dsa_key_restriction,omitempty" json:"dsa_key_restriction,omitempty"`
 	ECDSAKeyRestriction                       *int              `url:"ecdsa_key_restriction,omitempty" json:"ecdsa_key_restriction,omitempty"`
@@ -307,9 +326,12 @@ type UpdateSettingsOptions struct {
 	HTMLEmailsEnabled                         *bool             `url:"html_emails_enabled,omitempty" json:"html_emails_enabled,omitempty"`
 	ImportSources                             []string          `url:"import_sources,omitempty" json:"import_sources,omitempty"`
 	InstanceStatisticsVisibilityPrivate       *bool             `url:"instance_statistics_visibility_private,omitempty" json:"instance_statistics_visibility_private,omitempty"`
+	KeepLatestArtifact                        *bool             `url:"keep_latest_artifact,omitempty" json:"keep_latest_artifact,omitempty"`
 	LocalMarkdownVersion                      *int              `url:"local_markdown_version,omitempty" json:"local_markdown_version,omitempty"`
 	MaxArtifactsSize                          *int              `url:"max_artifacts_size,omitempty" json:"max_artifacts_size,omitempty"`
 	MaxAttachmentSize                         *int              `url:"max_attachment_size,omitempty" json:"max_attachment_size,omitempty"`
+	MaxExportSize                             *int              `url:"max_export_size,omitempty" json:"max_export_size,omitempty"`
+	MaxImportSize                             *int              `url:"max_import_size,omitempty" json:"max_import_size,omitempty"`
 	MaxPagesSize                              *int              `url:"max_pages_size,omitempty" json:"max_pages_size,omitempty"`
 	MetricsEnabled                            *bool             `url:"metrics_enabled,omitempty" json:"metrics_enabled,omitempty"`
 	MetricsHost                               *string           `url:"metrics_host,omitempty" json:"metrics_host,omitempty"`
@@ -330,6 +352,7 @@ type UpdateSettingsOptions struct {
 	PerformanceBarAllowedGroupID              *string           `url:"performance_bar_allowed_group_id,omitempty" json:"performance_bar_allowed_group_id,omitempty"`
 	PerformanceBarAllowedGroupPath            *string           `url:"performance_bar_allowed_group_path,omitempty" json:"performance_bar_allowed_group_path,omitempty"`
 	PerformanceBarEnabled                     *bool             `url:"performance_bar_enabled,omitempty" json:"performance_bar_enabled,omitempty"`
+	PersonalAccessTokenPrefix                 *string           `url:"personal_access_token_prefix,omitempty" json:"personal_access_token_prefix,omitempty"`
 	PlantumlEnabled                           *bool             `url:"plantuml_enabled,omitempty" json:"plantuml_enabled,omitempty"`
 	PlantumlURL                               *string           `url:"plantuml_url,omitempty" json:"plantuml_url,omitempty"`
 	PollingIntervalMultiplier                 *float64          `url:"polling_interval_multiplier,omitempty" json:"polling_interval_multiplier,omitempty"`
@@ -346,6 +369,7 @@ type UpdateSettingsOptions struct {
 	RepositoryChecksEnabled                   *bool             `url:"repository_checks_enabled,omitempty" json:"repository_checks_enabled,omitempty"`
 	RepositorySizeLimit                       *int              `url:"repository_size_limit,omitempty" json:"repository_size_limit,omitempty"`
 	RepositoryStorages                        []string          `url:"repository_storages,omitempty" json:"repository_storages,omitempty"`
+	RequireAdminApprovalAfterUserSignup       *bool             `url:"require_admin_approval_after_user_signup,omitempty" json:"require_admin_approval_after_user_signup,omitempty"`
 	RequireTwoFactorAuthentication            *bool             `url:"require_two_factor_authentication,omitempty" json:"require_two_factor_authentication,omitempty"`
 	RestrictedVisibilityLevels                []VisibilityValue `url:"restricted_visibility_levels,omitempty" json:"restricted_visibility_levels,omitempty"`
 	RsaKeyRestriction                         *int              `url:"rsa_key_restriction,omitempty" json:"rsa_key_restriction,omitempty"`
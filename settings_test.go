@@ -49,3 +49,64 @@ func TestUpdateSettings(t *testing.T) {
 		t.Errorf("Settings.UpdateSettings returned %+v, want %+v", settings, want)
 	}
 }
+
+func TestGetSettingsCommonFields(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{
+			"authorized_keys_enabled": true,
+			"default_branch_protection": 2,
+			"import_sources": ["github", "bitbucket"],
+			"max_attachment_size": 10,
+			"max_import_size": 50,
+			"signup_enabled": false,
+			"terminal_max_session_time": 3600
+		}`)
+	})
+
+	settings, _, err := client.Settings.GetSettings()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Settings{
+		AuthorizedKeysEnabled:   true,
+		DefaultBranchProtection: 2,
+		ImportSources:           []string{"github", "bitbucket"},
+		MaxAttachmentSize:       10,
+		MaxImportSize:           50,
+		TerminalMaxSessionTime:  3600,
+	}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Settings.GetSettings returned %+v, want %+v", settings, want)
+	}
+}
+
+func TestUpdateSettingsCommonFields(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/application/settings", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"default_branch_protection":2,"import_sources":["github"],"signup_enabled":false}`)
+		fmt.Fprint(w, `{"default_branch_protection":2,"import_sources":["github"],"signup_enabled":false}`)
+	})
+
+	options := &UpdateSettingsOptions{
+		DefaultBranchProtection: Int(2),
+		ImportSources:           []string{"github"},
+		SignupEnabled:           Bool(false),
+	}
+	settings, _, err := client.Settings.UpdateSettings(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Settings{DefaultBranchProtection: 2, ImportSources: []string{"github"}}
+	if !reflect.DeepEqual(settings, want) {
+		t.Errorf("Settings.UpdateSettings returned %+v, want %+v", settings, want)
+	}
+}
@@ -19,6 +19,7 @@ package gitlab
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -46,16 +47,48 @@ type Snippet struct {
 		State     string     `json:"state"`
 		CreatedAt *time.Time `json:"created_at"`
 	} `json:"author"`
-	UpdatedAt *time.Time `json:"updated_at"`
-	CreatedAt *time.Time `json:"created_at"`
-	WebURL    string     `json:"web_url"`
-	RawURL    string     `json:"raw_url"`
+	UpdatedAt *time.Time     `json:"updated_at"`
+	CreatedAt *time.Time     `json:"created_at"`
+	WebURL    string         `json:"web_url"`
+	RawURL    string         `json:"raw_url"`
+	Files     []*SnippetFile `json:"files"`
 }
 
 func (s Snippet) String() string {
 	return Stringify(s)
 }
 
+// SnippetFile represents a single file of a multi-file snippet.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/snippets.html
+type SnippetFile struct {
+	Path   string `json:"path"`
+	RawURL string `json:"raw_url"`
+}
+
+// CreateSnippetFileOptions represents a file when creating a multi-file
+// snippet.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#create-new-snippet
+type CreateSnippetFileOptions struct {
+	FilePath *string `url:"file_path,omitempty" json:"file_path,omitempty"`
+	Content  *string `url:"content,omitempty" json:"content,omitempty"`
+}
+
+// UpdateSnippetFileOptions represents a file change when updating a
+// multi-file snippet. Action is one of "create", "update", "delete" or
+// "move", PreviousPath is only used by the "move" action.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#update-snippet
+type UpdateSnippetFileOptions struct {
+	Action       *string `url:"action,omitempty" json:"action,omitempty"`
+	FilePath     *string `url:"file_path,omitempty" json:"file_path,omitempty"`
+	PreviousPath *string `url:"previous_path,omitempty" json:"previous_path,omitempty"`
+	Content      *string `url:"content,omitempty" json:"content,omitempty"`
+}
+
 // ListSnippetsOptions represents the available ListSnippets() options.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/snippets.html#list-snippets
@@ -105,11 +138,12 @@ func (s *SnippetsService) GetSnippet(snippet int, options ...RequestOptionFunc)
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/snippets.html#create-new-snippet
 type CreateSnippetOptions struct {
-	Title       *string          `url:"title,omitempty" json:"title,omitempty"`
-	FileName    *string          `url:"file_name,omitempty" json:"file_name,omitempty"`
-	Description *string          `url:"description,omitempty" json:"description,omitempty"`
-	Content     *string          `url:"content,omitempty" json:"content,omitempty"`
-	Visibility  *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Title       *string                     `url:"title,omitempty" json:"title,omitempty"`
+	FileName    *string                     `url:"file_name,omitempty" json:"file_name,omitempty"`
+	Description *string                     `url:"description,omitempty" json:"description,omitempty"`
+	Content     *string                     `url:"content,omitempty" json:"content,omitempty"`
+	Visibility  *VisibilityValue            `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Files       []*CreateSnippetFileOptions `url:"files,omitempty" json:"files,omitempty"`
 }
 
 // CreateSnippet creates a new snippet. The user must have permission
@@ -137,11 +171,12 @@ func (s *SnippetsService) CreateSnippet(opt *CreateSnippetOptions, options ...Re
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/snippets.html#update-snippet
 type UpdateSnippetOptions struct {
-	Title       *string          `url:"title,omitempty" json:"title,omitempty"`
-	FileName    *string          `url:"file_name,omitempty" json:"file_name,omitempty"`
-	Description *string          `url:"description,omitempty" json:"description,omitempty"`
-	Content     *string          `url:"content,omitempty" json:"content,omitempty"`
-	Visibility  *VisibilityValue `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Title       *string                     `url:"title,omitempty" json:"title,omitempty"`
+	FileName    *string                     `url:"file_name,omitempty" json:"file_name,omitempty"`
+	Description *string                     `url:"description,omitempty" json:"description,omitempty"`
+	Content     *string                     `url:"content,omitempty" json:"content,omitempty"`
+	Visibility  *VisibilityValue            `url:"visibility,omitempty" json:"visibility,omitempty"`
+	Files       []*UpdateSnippetFileOptions `url:"files,omitempty" json:"files,omitempty"`
 }
 
 // UpdateSnippet updates an existing snippet. The user must have
@@ -204,6 +239,38 @@ func (s *SnippetsService) SnippetContent(snippet int, options ...RequestOptionFu
 	return b.Bytes(), resp, err
 }
 
+// SnippetFileContent returns the raw content of a single file of a snippet
+// at the given ref. The complete file is kept in memory, use
+// SnippetFileContentToWriter for large files.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#snippet-repository-file-content
+func (s *SnippetsService) SnippetFileContent(snippet int, ref, fileName string, options ...RequestOptionFunc) ([]byte, *Response, error) {
+	var b bytes.Buffer
+	resp, err := s.SnippetFileContentToWriter(snippet, ref, fileName, &b, options...)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return b.Bytes(), resp, err
+}
+
+// SnippetFileContentToWriter streams the raw content of a single file of a
+// snippet at the given ref to w.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/snippets.html#snippet-repository-file-content
+func (s *SnippetsService) SnippetFileContentToWriter(snippet int, ref, fileName string, w io.Writer, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("snippets/%d/files/%s/%s/raw", snippet, pathEscape(ref), pathEscape(fileName))
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, w)
+}
+
 // ExploreSnippetsOptions represents the available ExploreSnippets() options.
 //
 // GitLab API docs:
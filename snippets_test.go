@@ -0,0 +1,86 @@
+package gitlab
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateMultiFileSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"This is a snippet","visibility":"internal","files":[{"file_path":"a.rb","content":"puts 'a'"},{"file_path":"b.rb","content":"puts 'b'"}]}`)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"title": "This is a snippet",
+			"files": [
+				{"path": "a.rb", "raw_url": "https://gitlab.example.com/-/snippets/1/raw/main/a.rb"},
+				{"path": "b.rb", "raw_url": "https://gitlab.example.com/-/snippets/1/raw/main/b.rb"}
+			]
+		}`)
+	})
+
+	opt := &CreateSnippetOptions{
+		Title:      String("This is a snippet"),
+		Visibility: Visibility(InternalVisibility),
+		Files: []*CreateSnippetFileOptions{
+			{FilePath: String("a.rb"), Content: String("puts 'a'")},
+			{FilePath: String("b.rb"), Content: String("puts 'b'")},
+		},
+	}
+	snippet, _, err := client.Snippets.CreateSnippet(opt)
+	require.NoError(t, err)
+
+	want := []*SnippetFile{
+		{Path: "a.rb", RawURL: "https://gitlab.example.com/-/snippets/1/raw/main/a.rb"},
+		{Path: "b.rb", RawURL: "https://gitlab.example.com/-/snippets/1/raw/main/b.rb"},
+	}
+	assert.Equal(t, want, snippet.Files)
+}
+
+func TestUpdateMultiFileSnippet(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"files":[{"action":"move","file_path":"c.rb","previous_path":"b.rb"}]}`)
+		fmt.Fprint(w, `{"id": 1, "files": [{"path": "a.rb"}, {"path": "c.rb"}]}`)
+	})
+
+	opt := &UpdateSnippetOptions{
+		Files: []*UpdateSnippetFileOptions{
+			{Action: String("move"), FilePath: String("c.rb"), PreviousPath: String("b.rb")},
+		},
+	}
+	snippet, _, err := client.Snippets.UpdateSnippet(1, opt)
+	require.NoError(t, err)
+	assert.Equal(t, []*SnippetFile{{Path: "a.rb"}, {Path: "c.rb"}}, snippet.Files)
+}
+
+func TestSnippetFileContent(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/snippets/1/files/main/lib/a.rb/raw", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/snippets/1/files/main/lib%2Fa%2Erb/raw")
+		fmt.Fprint(w, "puts 'a'")
+	})
+
+	content, _, err := client.Snippets.SnippetFileContent(1, "main", "lib/a.rb")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("puts 'a'"), content)
+
+	var b bytes.Buffer
+	_, err = client.Snippets.SnippetFileContentToWriter(1, "main", "lib/a.rb", &b)
+	require.NoError(t, err)
+	assert.Equal(t, "puts 'a'", b.String())
+}
@@ -33,9 +33,24 @@ type SystemHooksService struct {
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/system_hooks.html
 type Hook struct {
-	ID        int        `json:"id"`
-	URL       string     `json:"url"`
-	CreatedAt *time.Time `json:"created_at"`
+	ID                     int                `json:"id"`
+	URL                    string             `json:"url"`
+	CreatedAt              *time.Time         `json:"created_at"`
+	PushEvents             bool               `json:"push_events"`
+	TagPushEvents          bool               `json:"tag_push_events"`
+	MergeRequestsEvents    bool               `json:"merge_requests_events"`
+	RepositoryUpdateEvents bool               `json:"repository_update_events"`
+	EnableSSLVerification  bool               `json:"enable_ssl_verification"`
+	URLVariables           []*HookURLVariable `json:"url_variables"`
+}
+
+// HookURLVariable represents a URL variable of a system hook. The values of
+// URL variables are never returned by GitLab.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/system_hooks.html
+type HookURLVariable struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
 }
 
 func (h Hook) String() string {
@@ -66,13 +81,14 @@ func (s *SystemHooksService) ListHooks(options ...RequestOptionFunc) ([]*Hook, *
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/system_hooks.html#add-new-system-hook-hook
 type AddHookOptions struct {
-	URL                    *string `url:"url,omitempty" json:"url,omitempty"`
-	Token                  *string `url:"token,omitempty" json:"token,omitempty"`
-	PushEvents             *bool   `url:"push_events,omitempty" json:"push_events,omitempty"`
-	TagPushEvents          *bool   `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
-	MergeRequestsEvents    *bool   `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
-	RepositoryUpdateEvents *bool   `url:"repository_update_events,omitempty" json:"repository_update_events,omitempty"`
-	EnableSSLVerification  *bool   `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	URL                    *string            `url:"url,omitempty" json:"url,omitempty"`
+	Token                  *string            `url:"token,omitempty" json:"token,omitempty"`
+	PushEvents             *bool              `url:"push_events,omitempty" json:"push_events,omitempty"`
+	TagPushEvents          *bool              `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
+	MergeRequestsEvents    *bool              `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	RepositoryUpdateEvents *bool              `url:"repository_update_events,omitempty" json:"repository_update_events,omitempty"`
+	EnableSSLVerification  *bool              `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	URLVariables           []*HookURLVariable `url:"url_variables,omitempty" json:"url_variables,omitempty"`
 }
 
 // AddHook adds a new system hook hook.
@@ -94,6 +110,98 @@ func (s *SystemHooksService) AddHook(opt *AddHookOptions, options ...RequestOpti
 	return h, resp, err
 }
 
+// GetHook gets a single system hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/system_hooks.html#get-system-hook
+func (s *SystemHooksService) GetHook(hook int, options ...RequestOptionFunc) (*Hook, *Response, error) {
+	u := fmt.Sprintf("hooks/%d", hook)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := new(Hook)
+	resp, err := s.client.Do(req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, err
+}
+
+// EditHookOptions represents the available EditHook() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/system_hooks.html#update-system-hook
+type EditHookOptions struct {
+	URL                    *string            `url:"url,omitempty" json:"url,omitempty"`
+	Token                  *string            `url:"token,omitempty" json:"token,omitempty"`
+	PushEvents             *bool              `url:"push_events,omitempty" json:"push_events,omitempty"`
+	TagPushEvents          *bool              `url:"tag_push_events,omitempty" json:"tag_push_events,omitempty"`
+	MergeRequestsEvents    *bool              `url:"merge_requests_events,omitempty" json:"merge_requests_events,omitempty"`
+	RepositoryUpdateEvents *bool              `url:"repository_update_events,omitempty" json:"repository_update_events,omitempty"`
+	EnableSSLVerification  *bool              `url:"enable_ssl_verification,omitempty" json:"enable_ssl_verification,omitempty"`
+	URLVariables           []*HookURLVariable `url:"url_variables,omitempty" json:"url_variables,omitempty"`
+}
+
+// EditHook updates an existing system hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/system_hooks.html#update-system-hook
+func (s *SystemHooksService) EditHook(hook int, opt *EditHookOptions, options ...RequestOptionFunc) (*Hook, *Response, error) {
+	u := fmt.Sprintf("hooks/%d", hook)
+
+	req, err := s.client.NewRequest("PUT", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	h := new(Hook)
+	resp, err := s.client.Do(req, h)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return h, resp, err
+}
+
+// SetHookURLVariable sets the value of a URL variable of a system hook,
+// creating the variable if it does not exist.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/system_hooks.html#set-a-url-variable
+func (s *SystemHooksService) SetHookURLVariable(hook int, key, value string, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("hooks/%d/url_variables/%s", hook, pathEscape(key))
+
+	opt := struct {
+		Value string `url:"value" json:"value"`
+	}{value}
+
+	req, err := s.client.NewRequest("PUT", u, &opt, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// DeleteHookURLVariable deletes a URL variable of a system hook.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/system_hooks.html#delete-a-url-variable
+func (s *SystemHooksService) DeleteHookURLVariable(hook int, key string, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("hooks/%d/url_variables/%s", hook, pathEscape(key))
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // HookEvent represents an event trigger by a GitLab system hook.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/system_hooks.html
@@ -117,7 +225,7 @@ func (h HookEvent) String() string {
 func (s *SystemHooksService) TestHook(hook int, options ...RequestOptionFunc) (*HookEvent, *Response, error) {
 	u := fmt.Sprintf("hooks/%d", hook)
 
-	req, err := s.client.NewRequest("GET", u, nil, options)
+	req, err := s.client.NewRequest("POST", u, nil, options)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,101 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"url":"https://relay.example.com/{token}","push_events":true,"enable_ssl_verification":true,"url_variables":[{"key":"token","value":"secret"}]}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{
+			"id": 1,
+			"url": "https://relay.example.com/{token}",
+			"push_events": true,
+			"enable_ssl_verification": true,
+			"url_variables": [{"key": "token"}]
+		}`)
+	})
+
+	opt := &AddHookOptions{
+		URL:                   String("https://relay.example.com/{token}"),
+		PushEvents:            Bool(true),
+		EnableSSLVerification: Bool(true),
+		URLVariables:          []*HookURLVariable{{Key: "token", Value: "secret"}},
+	}
+	hook, _, err := client.SystemHooks.AddHook(opt)
+	require.NoError(t, err)
+
+	want := &Hook{
+		ID:                    1,
+		URL:                   "https://relay.example.com/{token}",
+		PushEvents:            true,
+		EnableSSLVerification: true,
+		URLVariables:          []*HookURLVariable{{Key: "token"}},
+	}
+	assert.Equal(t, want, hook)
+}
+
+func TestEditHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"tag_push_events":true,"repository_update_events":false}`)
+		fmt.Fprint(w, `{"id": 1, "tag_push_events": true}`)
+	})
+
+	opt := &EditHookOptions{TagPushEvents: Bool(true), RepositoryUpdateEvents: Bool(false)}
+	hook, _, err := client.SystemHooks.EditHook(1, opt)
+	require.NoError(t, err)
+	assert.Equal(t, &Hook{ID: 1, TagPushEvents: true}, hook)
+}
+
+func TestTestHook(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		fmt.Fprint(w, `{"project_id": 1, "owner_name": "Example", "name": "Ruby", "path": "ruby", "event_name": "project_create"}`)
+	})
+
+	event, _, err := client.SystemHooks.TestHook(1)
+	require.NoError(t, err)
+
+	want := &HookEvent{EventName: "project_create", Name: "Ruby", Path: "ruby", ProjectID: 1, OwnerName: "Example"}
+	assert.Equal(t, want, event)
+}
+
+func TestHookURLVariables(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/hooks/1/url_variables/token", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			testBody(t, r, `{"value":"secret"}`)
+			w.WriteHeader(http.StatusCreated)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request method %s", r.Method)
+		}
+	})
+
+	_, err := client.SystemHooks.SetHookURLVariable(1, "token", "secret")
+	require.NoError(t, err)
+
+	_, err = client.SystemHooks.DeleteHookURLVariable(1, "token")
+	require.NoError(t, err)
+}
@@ -23,6 +23,7 @@ const (
 	TodoBuildFailed       TodoAction = "build_failed"
 	TodoMarked            TodoAction = "marked"
 	TodoApprovalRequired  TodoAction = "approval_required"
+	TodoUnmergeable       TodoAction = "unmergeable"
 	TodoDirectlyAddressed TodoAction = "directly_addressed"
 )
 
@@ -119,12 +120,16 @@ func (t Todo) String() string {
 
 // ListTodosOptions represents the available ListTodos() options.
 //
+// State is either "pending" or "done". Type is the type of the todo target,
+// for example "Issue", "MergeRequest" or "Epic".
+//
 // GitLab API docs: https://docs.gitlab.com/ce/api/todos.html#get-a-list-of-todos
 type ListTodosOptions struct {
 	ListOptions
 	Action    *TodoAction `url:"action,omitempty" json:"action,omitempty"`
 	AuthorID  *int        `url:"author_id,omitempty" json:"author_id,omitempty"`
 	ProjectID *int        `url:"project_id,omitempty" json:"project_id,omitempty"`
+	GroupID   *int        `url:"group_id,omitempty" json:"group_id,omitempty"`
 	State     *string     `url:"state,omitempty" json:"state,omitempty"`
 	Type      *string     `url:"type,omitempty" json:"type,omitempty"`
 }
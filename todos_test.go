@@ -1,6 +1,7 @@
 package gitlab
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -25,6 +26,37 @@ func TestListTodos(t *testing.T) {
 	require.Equal(t, want, todos)
 }
 
+func TestListTodosWithFilters(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/todos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/todos?action=unmergeable&author_id=2&group_id=3&state=pending&type=MergeRequest")
+		fmt.Fprint(w, `[{"id":3,"action_name":"unmergeable","target_type":"MergeRequest","target":{"id":5,"iid":1,"merge_status":"cannot_be_merged"},"state":"pending"}]`)
+	})
+
+	action := TodoUnmergeable
+	opts := &ListTodosOptions{
+		Action:   &action,
+		AuthorID: Int(2),
+		GroupID:  Int(3),
+		State:    String("pending"),
+		Type:     String("MergeRequest"),
+	}
+	todos, _, err := client.Todos.ListTodos(opts)
+	require.NoError(t, err)
+
+	want := []*Todo{{
+		ID:         3,
+		ActionName: TodoUnmergeable,
+		TargetType: "MergeRequest",
+		Target:     TodoTarget{ID: 5, IID: 1, MergeStatus: "cannot_be_merged"},
+		State:      "pending",
+	}}
+	require.Equal(t, want, todos)
+}
+
 func TestMarkAllTodosAsDone(t *testing.T) {
 	mux, server, client := setup(t)
 	defer teardown(server)
@@ -61,6 +61,25 @@ func AccessLevel(v AccessLevelValue) *AccessLevelValue {
 	return p
 }
 
+// AvailabilityValue represents an availability value within GitLab.
+type AvailabilityValue string
+
+// List of available availability values.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#set-user-status
+const (
+	NotSet AvailabilityValue = "not_set"
+	Busy   AvailabilityValue = "busy"
+)
+
+// Availability is a helper routine that allocates a new AvailabilityValue
+// to store v and returns a pointer to it.
+func Availability(v AvailabilityValue) *AvailabilityValue {
+	p := new(AvailabilityValue)
+	*p = v
+	return p
+}
+
 // BuildStateValue represents a GitLab build state.
 type BuildStateValue string
 
@@ -104,6 +123,65 @@ func DeploymentStatus(v DeploymentStatusValue) *DeploymentStatusValue {
 	return p
 }
 
+// DORAMetricTypeValue represents all valid DORA metrics.
+type DORAMetricTypeValue string
+
+// These constants represent all valid DORA metrics.
+const (
+	DORAMetricDeploymentFrequency  DORAMetricTypeValue = "deployment_frequency"
+	DORAMetricLeadTimeForChanges   DORAMetricTypeValue = "lead_time_for_changes"
+	DORAMetricTimeToRestoreService DORAMetricTypeValue = "time_to_restore_service"
+	DORAMetricChangeFailureRate    DORAMetricTypeValue = "change_failure_rate"
+)
+
+// DORAMetricType is a helper routine that allocates a new DORAMetricTypeValue
+// to store v and returns a pointer to it.
+func DORAMetricType(v DORAMetricTypeValue) *DORAMetricTypeValue {
+	p := new(DORAMetricTypeValue)
+	*p = v
+	return p
+}
+
+// DORAMetricIntervalValue represents the time period over which DORA
+// metrics are aggregated.
+type DORAMetricIntervalValue string
+
+// These constants represent all valid DORA metric intervals.
+const (
+	DORAMetricIntervalAll     DORAMetricIntervalValue = "all"
+	DORAMetricIntervalMonthly DORAMetricIntervalValue = "monthly"
+	DORAMetricIntervalDaily   DORAMetricIntervalValue = "daily"
+)
+
+// DORAMetricInterval is a helper routine that allocates a new
+// DORAMetricIntervalValue to store v and returns a pointer to it.
+func DORAMetricInterval(v DORAMetricIntervalValue) *DORAMetricIntervalValue {
+	p := new(DORAMetricIntervalValue)
+	*p = v
+	return p
+}
+
+// FeatureFlagStrategyNameValue represents the name of a project feature flag
+// strategy.
+type FeatureFlagStrategyNameValue string
+
+// These constants represent all valid feature flag strategies.
+const (
+	DefaultFeatureFlagStrategy         FeatureFlagStrategyNameValue = "default"
+	GradualRolloutFeatureFlagStrategy  FeatureFlagStrategyNameValue = "gradualRolloutUserId"
+	UserWithIDFeatureFlagStrategy      FeatureFlagStrategyNameValue = "userWithId"
+	FlexibleRolloutFeatureFlagStrategy FeatureFlagStrategyNameValue = "flexibleRollout"
+	GitlabUserListFeatureFlagStrategy  FeatureFlagStrategyNameValue = "gitlabUserList"
+)
+
+// FeatureFlagStrategyName is a helper routine that allocates a new
+// FeatureFlagStrategyNameValue to store v and returns a pointer to it.
+func FeatureFlagStrategyName(v FeatureFlagStrategyNameValue) *FeatureFlagStrategyNameValue {
+	p := new(FeatureFlagStrategyNameValue)
+	*p = v
+	return p
+}
+
 // ISOTime represents an ISO 8601 formatted date
 type ISOTime time.Time
 
@@ -308,6 +386,29 @@ func VariableType(v VariableTypeValue) *VariableTypeValue {
 	return p
 }
 
+// LinkTypeValue represents a release link type.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/releases/links.html
+type LinkTypeValue string
+
+// List of available release link types.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/releases/links.html
+const (
+	ImageLinkType   LinkTypeValue = "image"
+	OtherLinkType   LinkTypeValue = "other"
+	PackageLinkType LinkTypeValue = "package"
+	RunbookLinkType LinkTypeValue = "runbook"
+)
+
+// LinkType is a helper routine that allocates a new LinkTypeValue
+// to store v and returns a pointer to it.
+func LinkType(v LinkTypeValue) *LinkTypeValue {
+	p := new(LinkTypeValue)
+	*p = v
+	return p
+}
+
 // MergeMethodValue represents a project merge type within GitLab.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#project-merge-method
@@ -366,6 +467,37 @@ const (
 	UserEventTargetType         EventTargetTypeValue = "user"
 )
 
+// EventTargetType is a helper routine that allocates a new
+// EventTargetTypeValue to store v and returns a pointer to it.
+func EventTargetType(v EventTargetTypeValue) *EventTargetTypeValue {
+	p := new(EventTargetTypeValue)
+	*p = v
+	return p
+}
+
+// ProjectHookEventValue represents an event that can be used to test a
+// project hook.
+type ProjectHookEventValue string
+
+// List of available project hook events
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/projects.html#trigger-a-test-project-hook
+const (
+	PushProjectHookEvent                ProjectHookEventValue = "push_events"
+	TagPushProjectHookEvent             ProjectHookEventValue = "tag_push_events"
+	IssuesProjectHookEvent              ProjectHookEventValue = "issues_events"
+	ConfidentialIssuesProjectHookEvent  ProjectHookEventValue = "confidential_issues_events"
+	NoteProjectHookEvent                ProjectHookEventValue = "note_events"
+	MergeRequestsProjectHookEvent       ProjectHookEventValue = "merge_requests_events"
+	JobProjectHookEvent                 ProjectHookEventValue = "job_events"
+	PipelineProjectHookEvent            ProjectHookEventValue = "pipeline_events"
+	WikiPageProjectHookEvent            ProjectHookEventValue = "wiki_page_events"
+	ReleasesProjectHookEvent            ProjectHookEventValue = "releases_events"
+	EmojiProjectHookEvent               ProjectHookEventValue = "emoji_events"
+	ResourceAccessTokenProjectHookEvent ProjectHookEventValue = "resource_access_token_events"
+)
+
 // Bool is a helper routine that allocates a new bool value
 // to store v and returns a pointer to it.
 func Bool(v bool) *bool {
@@ -374,6 +506,14 @@ func Bool(v bool) *bool {
 	return p
 }
 
+// Float64 is a helper routine that allocates a new float64 value
+// to store v and returns a pointer to it.
+func Float64(v float64) *float64 {
+	p := new(float64)
+	*p = v
+	return p
+}
+
 // Int is a helper routine that allocates a new int32 value
 // to store v and returns a pointer to it, but unlike Int32
 // its argument value is an int.
@@ -288,7 +288,9 @@ type SSHKey struct {
 	ID        int        `json:"id"`
 	Title     string     `json:"title"`
 	Key       string     `json:"key"`
+	UsageType string     `json:"usage_type"`
 	CreatedAt *time.Time `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
 }
 
 // ListSSHKeys gets a list of currently authenticated user's SSH keys.
@@ -361,8 +363,10 @@ func (s *UsersService) GetSSHKey(key int, options ...RequestOptionFunc) (*SSHKey
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/projects.html#add-ssh-key
 type AddSSHKeyOptions struct {
-	Title *string `url:"title,omitempty" json:"title,omitempty"`
-	Key   *string `url:"key,omitempty" json:"key,omitempty"`
+	Title     *string    `url:"title,omitempty" json:"title,omitempty"`
+	Key       *string    `url:"key,omitempty" json:"key,omitempty"`
+	UsageType *string    `url:"usage_type,omitempty" json:"usage_type,omitempty"`
+	ExpiresAt *time.Time `url:"expires_at,omitempty" json:"expires_at,omitempty"`
 }
 
 // AddSSHKey creates a new key owned by the currently authenticated user.
@@ -437,6 +441,172 @@ func (s *UsersService) DeleteSSHKeyForUser(user, key int, options ...RequestOpti
 	return s.client.Do(req, nil)
 }
 
+// GPGKey represents a GPG key.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-all-gpg-keys
+type GPGKey struct {
+	ID        int        `json:"id"`
+	Key       string     `json:"key"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+// ListGPGKeys gets a list of currently authenticated user's GPG keys.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#list-all-gpg-keys
+func (s *UsersService) ListGPGKeys(options ...RequestOptionFunc) ([]*GPGKey, *Response, error) {
+	req, err := s.client.NewRequest("GET", "user/gpg_keys", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ks []*GPGKey
+	resp, err := s.client.Do(req, &ks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ks, resp, err
+}
+
+// GetGPGKey gets a specific GPG key of currently authenticated user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#get-a-specific-gpg-key
+func (s *UsersService) GetGPGKey(key int, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	u := fmt.Sprintf("user/gpg_keys/%d", key)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// AddGPGKeyOptions represents the available AddGPGKey() options.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#add-a-gpg-key
+type AddGPGKeyOptions struct {
+	Key *string `url:"key,omitempty" json:"key,omitempty"`
+}
+
+// AddGPGKey creates a new GPG key owned by the currently authenticated user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#add-a-gpg-key
+func (s *UsersService) AddGPGKey(opt *AddGPGKeyOptions, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	req, err := s.client.NewRequest("POST", "user/gpg_keys", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// DeleteGPGKey deletes a GPG key owned by currently authenticated user.
+//
+// GitLab API docs: https://docs.gitlab.com/ce/api/users.html#delete-a-gpg-key
+func (s *UsersService) DeleteGPGKey(key int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("user/gpg_keys/%d", key)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
+// ListGPGKeysForUser gets a list of a specified user's GPG keys.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#list-all-gpg-keys-for-given-user
+func (s *UsersService) ListGPGKeysForUser(user int, options ...RequestOptionFunc) ([]*GPGKey, *Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys", user)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ks []*GPGKey
+	resp, err := s.client.Do(req, &ks)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ks, resp, err
+}
+
+// GetGPGKeyForUser gets a specific GPG key of a specified user.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#get-a-specific-gpg-key-for-a-given-user
+func (s *UsersService) GetGPGKeyForUser(user, key int, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys/%d", user, key)
+
+	req, err := s.client.NewRequest("GET", u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// AddGPGKeyForUser creates a new GPG key owned by the specified user.
+// Available only for admin.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#add-a-gpg-key-for-a-given-user
+func (s *UsersService) AddGPGKeyForUser(user int, opt *AddGPGKeyOptions, options ...RequestOptionFunc) (*GPGKey, *Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys", user)
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	k := new(GPGKey)
+	resp, err := s.client.Do(req, k)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return k, resp, err
+}
+
+// DeleteGPGKeyForUser deletes a GPG key owned by a specified user. Available
+// only for admin.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ce/api/users.html#delete-a-gpg-key-for-a-given-user
+func (s *UsersService) DeleteGPGKeyForUser(user, key int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("users/%d/gpg_keys/%d", user, key)
+
+	req, err := s.client.NewRequest("DELETE", u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}
+
 // BlockUser blocks the specified user. Available only for admin.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/users.html#block-user
@@ -702,23 +872,29 @@ func (s *UsersService) DeleteEmailForUser(user, email int, options ...RequestOpt
 	return s.client.Do(req, nil)
 }
 
-// ImpersonationToken represents an impersonation token.
+// ImpersonationToken represents an impersonation token. The Token field is
+// only set in the response of CreateImpersonationToken, it is never returned
+// again afterwards.
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#get-all-impersonation-tokens-of-a-user
 type ImpersonationToken struct {
-	ID        int        `json:"id"`
-	Name      string     `json:"name"`
-	Active    bool       `json:"active"`
-	Token     string     `json:"token"`
-	Scopes    []string   `json:"scopes"`
-	Revoked   bool       `json:"revoked"`
-	CreatedAt *time.Time `json:"created_at"`
-	ExpiresAt *ISOTime   `json:"expires_at"`
+	ID            int        `json:"id"`
+	Name          string     `json:"name"`
+	Active        bool       `json:"active"`
+	Token         string     `json:"token"`
+	Scopes        []string   `json:"scopes"`
+	Revoked       bool       `json:"revoked"`
+	Impersonation bool       `json:"impersonation"`
+	UserID        int        `json:"user_id"`
+	CreatedAt     *time.Time `json:"created_at"`
+	LastUsedAt    *time.Time `json:"last_used_at"`
+	ExpiresAt     *ISOTime   `json:"expires_at"`
 }
 
 // GetAllImpersonationTokensOptions represents the available
-// GetAllImpersonationTokens() options.
+// GetAllImpersonationTokens() options. State is either "active" or
+// "inactive".
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#get-all-impersonation-tokens-of-a-user
@@ -858,9 +1034,11 @@ func (s *UsersService) GetUserActivities(opt *GetUserActivitiesOptions, options
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#user-status
 type UserStatus struct {
-	Emoji       string `json:"emoji"`
-	Message     string `json:"message"`
-	MessageHTML string `json:"message_html"`
+	Emoji         string            `json:"emoji"`
+	Availability  AvailabilityValue `json:"availability"`
+	Message       string            `json:"message"`
+	MessageHTML   string            `json:"message_html"`
+	ClearStatusAt *time.Time        `json:"clear_status_at"`
 }
 
 // CurrentUserStatus retrieves the user status
@@ -903,13 +1081,17 @@ func (s *UsersService) GetUserStatus(user int, options ...RequestOptionFunc) (*U
 	return status, resp, err
 }
 
-// UserStatusOptions represents the options required to set the status
+// UserStatusOptions represents the options required to set the status.
+// ClearStatusAfter is one of "30_minutes", "3_hours", "8_hours", "1_day",
+// "3_days", "7_days" or "30_days".
 //
 // GitLab API docs:
 // https://docs.gitlab.com/ce/api/users.html#set-user-status
 type UserStatusOptions struct {
-	Emoji   *string `url:"emoji,omitempty" json:"emoji,omitempty"`
-	Message *string `url:"message,omitempty" json:"message,omitempty"`
+	Emoji            *string            `url:"emoji,omitempty" json:"emoji,omitempty"`
+	Message          *string            `url:"message,omitempty" json:"message,omitempty"`
+	Availability     *AvailabilityValue `url:"availability,omitempty" json:"availability,omitempty"`
+	ClearStatusAfter *string            `url:"clear_status_after,omitempty" json:"clear_status_after,omitempty"`
 }
 
 // SetUserStatus sets the user's status
@@ -3,7 +3,9 @@ package gitlab
 import (
 	"fmt"
 	"net/http"
+	"reflect"
 	"testing"
+	"time"
 )
 
 func TestBlockUser(t *testing.T) {
@@ -234,3 +236,207 @@ func TestActivateUser_UserNotFound(t *testing.T) {
 		t.Errorf("Users.ActivateUser error.\nExpected: %+v\n\tGot: %+v", ErrUserNotFound, err)
 	}
 }
+
+func TestAddSSHKeyWithUsageType(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%suser/keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"title":"laptop","key":"ssh-ed25519 AAAA","usage_type":"signing","expires_at":"2030-01-01T00:00:00Z"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":1,"title":"laptop","key":"ssh-ed25519 AAAA","usage_type":"signing","expires_at":"2030-01-01T00:00:00Z"}`)
+	})
+
+	expiresAt := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opt := &AddSSHKeyOptions{
+		Title:     String("laptop"),
+		Key:       String("ssh-ed25519 AAAA"),
+		UsageType: String("signing"),
+		ExpiresAt: &expiresAt,
+	}
+	key, _, err := client.Users.AddSSHKey(opt)
+	if err != nil {
+		t.Fatalf("Users.AddSSHKey returned error: %v", err)
+	}
+
+	want := &SSHKey{ID: 1, Title: "laptop", Key: "ssh-ed25519 AAAA", UsageType: "signing", ExpiresAt: &expiresAt}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Users.AddSSHKey returned %+v, want %+v", key, want)
+	}
+}
+
+func TestListGPGKeys(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%suser/gpg_keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `[{"id":1,"key":"-----BEGIN PGP PUBLIC KEY BLOCK-----","created_at":"2017-09-05T09:17:46.264Z"}]`)
+	})
+
+	keys, _, err := client.Users.ListGPGKeys()
+	if err != nil {
+		t.Fatalf("Users.ListGPGKeys returned error: %v", err)
+	}
+
+	createdAt := time.Date(2017, time.September, 5, 9, 17, 46, 264000000, time.UTC)
+	want := []*GPGKey{{ID: 1, Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----", CreatedAt: &createdAt}}
+	if !reflect.DeepEqual(want, keys) {
+		t.Errorf("Users.ListGPGKeys returned %+v, want %+v", keys, want)
+	}
+}
+
+func TestAddGPGKeyForUser(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/gpg_keys", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"key":"-----BEGIN PGP PUBLIC KEY BLOCK-----"}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":3,"key":"-----BEGIN PGP PUBLIC KEY BLOCK-----"}`)
+	})
+
+	key, _, err := client.Users.AddGPGKeyForUser(2, &AddGPGKeyOptions{Key: String("-----BEGIN PGP PUBLIC KEY BLOCK-----")})
+	if err != nil {
+		t.Fatalf("Users.AddGPGKeyForUser returned error: %v", err)
+	}
+
+	want := &GPGKey{ID: 3, Key: "-----BEGIN PGP PUBLIC KEY BLOCK-----"}
+	if !reflect.DeepEqual(want, key) {
+		t.Errorf("Users.AddGPGKeyForUser returned %+v, want %+v", key, want)
+	}
+}
+
+func TestDeleteGPGKey(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%suser/gpg_keys/1", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.DeleteGPGKey(1)
+	if err != nil {
+		t.Errorf("Users.DeleteGPGKey returned error: %v", err)
+	}
+}
+
+func TestGetAllImpersonationTokens(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/impersonation_tokens", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		testURL(t, r, "/api/v4/users/2/impersonation_tokens?state=active")
+		fmt.Fprint(w, `[{"id":2,"name":"svc","active":true,"impersonation":true,"user_id":2,"scopes":["api"],"expires_at":"2030-01-01"}]`)
+	})
+
+	tokens, _, err := client.Users.GetAllImpersonationTokens(2, &GetAllImpersonationTokensOptions{State: String("active")})
+	if err != nil {
+		t.Fatalf("Users.GetAllImpersonationTokens returned error: %v", err)
+	}
+
+	expiresAt := ISOTime(time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC))
+	want := []*ImpersonationToken{{ID: 2, Name: "svc", Active: true, Impersonation: true, UserID: 2, Scopes: []string{"api"}, ExpiresAt: &expiresAt}}
+	if !reflect.DeepEqual(want, tokens) {
+		t.Errorf("Users.GetAllImpersonationTokens returned %+v, want %+v", tokens, want)
+	}
+}
+
+func TestCreateImpersonationToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/impersonation_tokens", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"name":"svc","scopes":["api","read_user"]}`)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id":3,"name":"svc","active":true,"impersonation":true,"scopes":["api","read_user"],"token":"EsMo-vhKfXGwX9RKrwiy"}`)
+	})
+
+	opt := &CreateImpersonationTokenOptions{Name: String("svc"), Scopes: &[]string{"api", "read_user"}}
+	token, _, err := client.Users.CreateImpersonationToken(2, opt)
+	if err != nil {
+		t.Fatalf("Users.CreateImpersonationToken returned error: %v", err)
+	}
+
+	want := &ImpersonationToken{ID: 3, Name: "svc", Active: true, Impersonation: true, Scopes: []string{"api", "read_user"}, Token: "EsMo-vhKfXGwX9RKrwiy"}
+	if !reflect.DeepEqual(want, token) {
+		t.Errorf("Users.CreateImpersonationToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestRevokeImpersonationToken(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/impersonation_tokens/3", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "DELETE")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Users.RevokeImpersonationToken(2, 3)
+	if err != nil {
+		t.Errorf("Users.RevokeImpersonationToken returned error: %v", err)
+	}
+}
+
+func TestSetUserStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%suser/status", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		testBody(t, r, `{"emoji":"pager","message":"paged","availability":"busy","clear_status_after":"8_hours"}`)
+		fmt.Fprint(w, `{"emoji":"pager","availability":"busy","message":"paged","message_html":"paged","clear_status_at":"2021-03-01T18:00:00Z"}`)
+	})
+
+	opt := &UserStatusOptions{
+		Emoji:            String("pager"),
+		Message:          String("paged"),
+		Availability:     Availability(Busy),
+		ClearStatusAfter: String("8_hours"),
+	}
+	status, _, err := client.Users.SetUserStatus(opt)
+	if err != nil {
+		t.Fatalf("Users.SetUserStatus returned error: %v", err)
+	}
+
+	clearStatusAt := time.Date(2021, time.March, 1, 18, 0, 0, 0, time.UTC)
+	want := &UserStatus{Emoji: "pager", Availability: Busy, Message: "paged", MessageHTML: "paged", ClearStatusAt: &clearStatusAt}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("Users.SetUserStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestGetUserStatus(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	path := fmt.Sprintf("/%susers/2/status", apiVersionPath)
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "GET")
+		fmt.Fprint(w, `{"emoji":"","availability":"not_set","message":null}`)
+	})
+
+	status, _, err := client.Users.GetUserStatus(2)
+	if err != nil {
+		t.Fatalf("Users.GetUserStatus returned error: %v", err)
+	}
+
+	want := &UserStatus{Availability: NotSet}
+	if !reflect.DeepEqual(want, status) {
+		t.Errorf("Users.GetUserStatus returned %+v, want %+v", status, want)
+	}
+}
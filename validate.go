@@ -1,5 +1,9 @@
 package gitlab
 
+import (
+	"fmt"
+)
+
 // ValidateService handles communication with the validation related methods of
 // the GitLab API.
 //
@@ -8,12 +12,42 @@ type ValidateService struct {
 	client *Client
 }
 
-// LintResult represents the linting results.
+// LintResult represents the linting results. Valid is also set for GitLab
+// versions that only report the Status.
 //
 // GitLab API docs: https://docs.gitlab.com/ce/api/lint.html
 type LintResult struct {
-	Status string   `json:"status"`
-	Errors []string `json:"errors"`
+	Valid      bool     `json:"valid"`
+	Status     string   `json:"status"`
+	Errors     []string `json:"errors"`
+	Warnings   []string `json:"warnings"`
+	MergedYaml string   `json:"merged_yaml"`
+}
+
+// ProjectLintResult represents the linting results by project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-a-ci-yaml-configuration-with-a-namespace
+type ProjectLintResult struct {
+	Valid      bool      `json:"valid"`
+	Errors     []string  `json:"errors"`
+	Warnings   []string  `json:"warnings"`
+	MergedYaml string    `json:"merged_yaml"`
+	Jobs       []LintJob `json:"jobs,omitempty"`
+}
+
+// LintJob represents a job of an expanded CI configuration, as returned when
+// IncludeJobs is set.
+type LintJob struct {
+	Name         string   `json:"name"`
+	Stage        string   `json:"stage"`
+	BeforeScript []string `json:"before_script"`
+	Script       []string `json:"script"`
+	AfterScript  []string `json:"after_script"`
+	TagList      []string `json:"tag_list"`
+	Environment  string   `json:"environment"`
+	When         string   `json:"when"`
+	AllowFailure bool     `json:"allow_failure"`
 }
 
 // Lint validates .gitlab-ci.yml content.
@@ -35,6 +69,77 @@ func (s *ValidateService) Lint(content string, options ...RequestOptionFunc) (*L
 	if err != nil {
 		return nil, resp, err
 	}
+	l.Valid = l.Valid || l.Status == "valid"
+
+	return l, resp, nil
+}
+
+// LintOptions represents the available LintWithOptions() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-the-ci-yaml-configuration
+type LintOptions struct {
+	Content           *string `url:"content,omitempty" json:"content,omitempty"`
+	IncludeMergedYAML *bool   `url:"include_merged_yaml,omitempty" json:"include_merged_yaml,omitempty"`
+	IncludeJobs       *bool   `url:"include_jobs,omitempty" json:"include_jobs,omitempty"`
+}
+
+// LintWithOptions validates .gitlab-ci.yml content using the given options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-the-ci-yaml-configuration
+func (s *ValidateService) LintWithOptions(opt *LintOptions, options ...RequestOptionFunc) (*LintResult, *Response, error) {
+	req, err := s.client.NewRequest("POST", "ci/lint", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(LintResult)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
+	l.Valid = l.Valid || l.Status == "valid"
+
+	return l, resp, nil
+}
+
+// ProjectLintOptions represents the available ProjectLint() options.
+//
+// When DryRun is set, the pipeline creation is simulated (optionally for the
+// given DryRunRef) and the expanded configuration is returned.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-a-ci-yaml-configuration-with-a-namespace
+type ProjectLintOptions struct {
+	Content     *string `url:"content,omitempty" json:"content,omitempty"`
+	DryRun      *bool   `url:"dry_run,omitempty" json:"dry_run,omitempty"`
+	DryRunRef   *string `url:"dry_run_ref,omitempty" json:"dry_run_ref,omitempty"`
+	IncludeJobs *bool   `url:"include_jobs,omitempty" json:"include_jobs,omitempty"`
+}
+
+// ProjectLint validates .gitlab-ci.yml content in the namespace of a
+// project, so includes and references are resolved using the project.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/lint.html#validate-a-ci-yaml-configuration-with-a-namespace
+func (s *ValidateService) ProjectLint(pid interface{}, opt *ProjectLintOptions, options ...RequestOptionFunc) (*ProjectLintResult, *Response, error) {
+	project, err := parseID(pid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("projects/%s/ci/lint", pathEscape(project))
+
+	req, err := s.client.NewRequest("POST", u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	l := new(ProjectLintResult)
+	resp, err := s.client.Do(req, l)
+	if err != nil {
+		return nil, resp, err
+	}
 
 	return l, resp, nil
 }
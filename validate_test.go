@@ -26,6 +26,7 @@ func TestValidate(t *testing.T) {
 				"errors": []
 			}`,
 			want: &LintResult{
+				Valid:  true,
 				Status: "valid",
 				Errors: []string{},
 			},
@@ -69,3 +70,75 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateLintWithOptions(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/ci/lint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"content":"build1:\n  script: echo","include_merged_yaml":true}`)
+		fmt.Fprint(w, `{
+			"valid": true,
+			"errors": [],
+			"warnings": ["jobs:build1 may allow multiple pipelines to run"],
+			"merged_yaml": "---\nbuild1:\n  script: echo\n"
+		}`)
+	})
+
+	opt := &LintOptions{
+		Content:           String("build1:\n  script: echo"),
+		IncludeMergedYAML: Bool(true),
+	}
+
+	got, _, err := client.Validate.LintWithOptions(opt)
+	if err != nil {
+		t.Fatalf("Validate.LintWithOptions returned error: %v", err)
+	}
+
+	want := &LintResult{
+		Valid:      true,
+		Errors:     []string{},
+		Warnings:   []string{"jobs:build1 may allow multiple pipelines to run"},
+		MergedYaml: "---\nbuild1:\n  script: echo\n",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Validate.LintWithOptions returned \ngot:\n%v\nwant:\n%v", Stringify(got), Stringify(want))
+	}
+}
+
+func TestValidateProjectLint(t *testing.T) {
+	mux, server, client := setup(t)
+	defer teardown(server)
+
+	mux.HandleFunc("/api/v4/projects/1/ci/lint", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		testBody(t, r, `{"content":"build1:\n  script: echo","dry_run":true}`)
+		fmt.Fprint(w, `{
+			"valid": true,
+			"errors": [],
+			"warnings": [],
+			"merged_yaml": "---\nbuild1:\n  script: echo\n"
+		}`)
+	})
+
+	opt := &ProjectLintOptions{
+		Content: String("build1:\n  script: echo"),
+		DryRun:  Bool(true),
+	}
+
+	got, _, err := client.Validate.ProjectLint(1, opt)
+	if err != nil {
+		t.Fatalf("Validate.ProjectLint returned error: %v", err)
+	}
+
+	want := &ProjectLintResult{
+		Valid:      true,
+		Errors:     []string{},
+		Warnings:   []string{},
+		MergedYaml: "---\nbuild1:\n  script: echo\n",
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("Validate.ProjectLint returned \ngot:\n%v\nwant:\n%v", Stringify(got), Stringify(want))
+	}
+}